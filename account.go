@@ -0,0 +1,54 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+)
+
+type AccountResponse struct {
+	Account Account `json:"account"`
+}
+
+// Account is used to represent the account the token belongs to.
+// Status is one of "active", "warning" or "locked".
+type Account struct {
+	DropletLimit    int    `json:"droplet_limit"`
+	FloatingIPLimit int    `json:"floating_ip_limit"`
+	Email           string `json:"email"`
+	UUID            string `json:"uuid"`
+	EmailVerified   bool   `json:"email_verified"`
+	Status          string `json:"status"`
+	StatusMessage   string `json:"status_message"`
+}
+
+// RetrieveAccount gets the account the token belongs to and returns
+// an Account and an error. An error will be returned for failed
+// requests with a nil Account.
+func (c *Client) RetrieveAccount() (Account, error) {
+	return c.RetrieveAccountContext(context.Background())
+}
+
+// RetrieveAccountContext is the context-aware form of RetrieveAccount.
+func (c *Client) RetrieveAccountContext(ctx context.Context) (Account, error) {
+	req, err := c.NewRequestContext(ctx, nil, "GET", "/account")
+
+	if err != nil {
+		return Account{}, err
+	}
+
+	resp, err := checkResp(c.do(req))
+	if err != nil {
+		return Account{}, fmt.Errorf("Error retrieving account: %w", err)
+	}
+
+	account := new(AccountResponse)
+
+	err = decodeBody(resp, account)
+
+	if err != nil {
+		return Account{}, fmt.Errorf("Error decoding account response: %w", err)
+	}
+
+	// The request was successful
+	return account.Account, nil
+}
@@ -0,0 +1,51 @@
+package digitalocean
+
+import (
+	"testing"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestAccount(t *testing.T) {
+	TestingT(t)
+}
+
+func (s *S) Test_RetrieveAccount(c *C) {
+	testServer.Response(200, nil, accountExample)
+
+	account, err := s.client.RetrieveAccount()
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/account")
+	c.Assert(account.DropletLimit, Equals, 3)
+	c.Assert(account.FloatingIPLimit, Equals, 5)
+	c.Assert(account.Email, Equals, "sammy@digitalocean.com")
+	c.Assert(account.UUID, Equals, "b6fr89dbf6d9156cace5f3c78dc9851d957381ef")
+	c.Assert(account.EmailVerified, Equals, true)
+	c.Assert(account.Status, Equals, "active")
+}
+
+func (s *S) Test_VerifyAuthentication(c *C) {
+	testServer.Response(200, nil, accountExample)
+
+	err := s.client.VerifyAuthentication()
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/account")
+}
+
+var accountExample = `{
+  "account": {
+    "droplet_limit": 3,
+    "floating_ip_limit": 5,
+    "email": "sammy@digitalocean.com",
+    "uuid": "b6fr89dbf6d9156cace5f3c78dc9851d957381ef",
+    "email_verified": true,
+    "status": "active",
+    "status_message": ""
+  }
+}`
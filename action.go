@@ -0,0 +1,174 @@
+package digitalocean
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrActionErrored is wrapped by the error WaitForAction returns
+// when the action finished in the errored state
+var ErrActionErrored = errors.New("action errored")
+
+type ActionResponse struct {
+	Action Action `json:"action"`
+}
+
+// Action is used to represent an action taken on a resource, such
+// as a resize of a Droplet. Status is one of "in-progress",
+// "completed" or "errored".
+type Action struct {
+	Id           int64     `json:"id"`
+	Status       string    `json:"status"`
+	Type         string    `json:"type"`
+	StartedAt    time.Time `json:"started_at"`
+	CompletedAt  time.Time `json:"completed_at"`
+	ResourceId   int64     `json:"resource_id"`
+	ResourceType string    `json:"resource_type"`
+	Region       Region    `json:"region"`
+	RegionSlug   string    `json:"region_slug"`
+}
+
+// Returns the ID of the action as a string
+func (a *Action) StringId() string {
+	return strconv.FormatInt(a.Id, 10)
+}
+
+// Returns whether the action has finished, successfully or not
+func (a *Action) Done() bool {
+	return a.Status == "completed" || a.Status == "errored"
+}
+
+// decodeAction decodes the Action of an action response
+func decodeAction(resp *http.Response) (Action, error) {
+	defer resp.Body.Close()
+
+	action := new(ActionResponse)
+
+	err := decodeBody(resp, action)
+
+	if err != nil {
+		return Action{}, fmt.Errorf("Error decoding action response: %w", err)
+	}
+
+	// The request was successful
+	return action.Action, nil
+}
+
+// RetrieveAction gets an action by the ID specified and returns
+// an Action and an error. An error will be returned for failed
+// requests with a nil Action.
+func (c *Client) RetrieveAction(id string) (Action, error) {
+	return c.RetrieveActionContext(context.Background(), id)
+}
+
+// RetrieveActionContext is the context-aware form of RetrieveAction.
+func (c *Client) RetrieveActionContext(ctx context.Context, id string) (Action, error) {
+	req, err := c.NewRequestContext(ctx, nil, "GET", fmt.Sprintf("/actions/%s", id))
+
+	if err != nil {
+		return Action{}, err
+	}
+
+	resp, err := checkResp(c.do(req))
+	if err != nil {
+		return Action{}, fmt.Errorf("Error retrieving action: %w", err)
+	}
+
+	return decodeAction(resp)
+}
+
+// RetrieveActions gets the list of all actions taken on the account,
+// following every page, and an error. An error will be returned for
+// failed requests with a nil slice.
+func (c *Client) RetrieveActions() ([]Action, error) {
+	return c.RetrieveActionsContext(context.Background())
+}
+
+// RetrieveActionsContext is the context-aware form of RetrieveActions.
+func (c *Client) RetrieveActionsContext(ctx context.Context) ([]Action, error) {
+	actions, err := collect(c.IterateActionsContext(ctx))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving actions: %w", err)
+	}
+
+	// The request was successful
+	return actions, nil
+}
+
+// IterateActions returns an Iterator over all actions taken on the
+// account, most recent first.
+func (c *Client) IterateActions() *Iterator[Action] {
+	return c.IterateActionsContext(context.Background())
+}
+
+// IterateActionsContext is the context-aware form of IterateActions.
+func (c *Client) IterateActionsContext(ctx context.Context) *Iterator[Action] {
+	return newIterator[Action](ctx, c, "/actions", "actions")
+}
+
+// WaitOptions contains the parameters for waiting on an action
+type WaitOptions struct {
+	// Interval between two polls of the action. Defaults to
+	// five seconds.
+	Interval time.Duration
+
+	// Timeout after which to give up waiting. Zero means to wait
+	// as long as the context allows.
+	Timeout time.Duration
+
+	// Progress, if set, is called with the action after every poll
+	Progress func(Action)
+}
+
+// WaitForAction polls the action by the ID specified until it is
+// done, and returns it. An error wrapping ErrActionErrored is
+// returned, along with the action, if it errored.
+func (c *Client) WaitForAction(id string, opts *WaitOptions) (Action, error) {
+	return c.WaitForActionContext(context.Background(), id, opts)
+}
+
+// WaitForActionContext is the context-aware form of WaitForAction.
+func (c *Client) WaitForActionContext(ctx context.Context, id string, opts *WaitOptions) (Action, error) {
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	for {
+		action, err := c.RetrieveActionContext(ctx, id)
+
+		if err != nil {
+			return Action{}, fmt.Errorf("Error waiting for action %s: %w", id, err)
+		}
+
+		if opts.Progress != nil {
+			opts.Progress(action)
+		}
+
+		switch action.Status {
+		case "completed":
+			return action, nil
+		case "errored":
+			return action, fmt.Errorf("Error waiting for action %s: %s %w", id, action.Type, ErrActionErrored)
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return action, fmt.Errorf("Error waiting for action %s: %w", id, err)
+		}
+	}
+}
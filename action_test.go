@@ -0,0 +1,187 @@
+package digitalocean
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestAction(t *testing.T) {
+	TestingT(t)
+}
+
+func (s *S) Test_RetrieveAction(c *C) {
+	testServer.Response(200, nil, actionExample)
+
+	action, err := s.client.RetrieveAction("36804636")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/actions/36804636")
+	c.Assert(action.StringId(), Equals, "36804636")
+	c.Assert(action.Type, Equals, "create")
+	c.Assert(action.ResourceId, Equals, int64(3164444))
+	c.Assert(action.ResourceType, Equals, "droplet")
+	c.Assert(action.Region.Name, Equals, "New York 3")
+	c.Assert(action.RegionSlug, Equals, "nyc3")
+	c.Assert(action.CompletedAt.Unix(), Equals, int64(1415041435))
+	c.Assert(action.Done(), Equals, true)
+}
+
+func (s *S) Test_WaitForAction(c *C) {
+	testServer.Response(200, nil, dropletExampleAction)
+	testServer.Response(200, nil, actionExample)
+
+	var seen []string
+	opts := &WaitOptions{
+		Interval: time.Millisecond,
+		Progress: func(a Action) {
+			seen = append(seen, a.Status)
+		},
+	}
+
+	action, err := s.client.WaitForAction("36804636", opts)
+
+	_ = testServer.WaitRequests(2)
+
+	c.Assert(err, IsNil)
+	c.Assert(action.Status, Equals, "completed")
+	c.Assert(seen, DeepEquals, []string{"in-progress", "completed"})
+}
+
+func (s *S) Test_WaitForAction_errored(c *C) {
+	testServer.Response(200, nil, actionErroredExample)
+
+	action, err := s.client.WaitForAction("36804636", nil)
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, ErrorMatches, "Error waiting for action 36804636: resize action errored")
+	c.Assert(errors.Is(err, ErrActionErrored), Equals, true)
+	c.Assert(action.Status, Equals, "errored")
+}
+
+func (s *S) Test_WaitForAction_timeout(c *C) {
+	testServer.Response(200, nil, dropletExampleAction)
+
+	opts := &WaitOptions{
+		Interval: time.Second,
+		Timeout:  10 * time.Millisecond,
+	}
+
+	action, err := s.client.WaitForAction("15", opts)
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, ErrorMatches, "Error waiting for action 15: context deadline exceeded")
+	c.Assert(action.Status, Equals, "in-progress")
+}
+
+func (s *S) Test_RetrieveActions(c *C) {
+	testServer.Response(200, nil, actionsPageOneExample)
+	testServer.Response(200, nil, actionsPageTwoExample)
+
+	actions, err := s.client.RetrieveActions()
+
+	reqs := testServer.WaitRequests(2)
+
+	c.Assert(err, IsNil)
+	c.Assert(reqs[0].URL.Path, Equals, "/actions")
+	c.Assert(reqs[1].URL.Query().Get("page"), Equals, "2")
+	c.Assert(actions, HasLen, 2)
+	c.Assert(actions[0].Type, Equals, "create")
+	c.Assert(actions[1].Type, Equals, "power_off")
+}
+
+func (s *S) Test_RetrieveDropletActions(c *C) {
+	testServer.Response(200, nil, actionsPageTwoExample)
+
+	actions, err := s.client.RetrieveDropletActions("3164444")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/droplets/3164444/actions")
+	c.Assert(actions, HasLen, 1)
+	c.Assert(actions[0].ResourceId, Equals, int64(3164444))
+}
+
+var actionsPageOneExample = `{
+  "actions": [
+    {
+      "id": 36804636,
+      "status": "completed",
+      "type": "create",
+      "started_at": "2014-11-03T19:03:29Z",
+      "completed_at": "2014-11-03T19:03:55Z",
+      "resource_id": 3164444,
+      "resource_type": "droplet",
+      "region": "nyc3",
+      "region_slug": "nyc3"
+    }
+  ],
+  "links": {
+    "pages": {
+      "last": "http://localhost:4444/actions?page=2",
+      "next": "http://localhost:4444/actions?page=2"
+    }
+  },
+  "meta": {
+    "total": 2
+  }
+}`
+
+var actionsPageTwoExample = `{
+  "actions": [
+    {
+      "id": 36804637,
+      "status": "in-progress",
+      "type": "power_off",
+      "started_at": "2014-11-03T19:05:29Z",
+      "completed_at": null,
+      "resource_id": 3164444,
+      "resource_type": "droplet",
+      "region": "nyc3",
+      "region_slug": "nyc3"
+    }
+  ],
+  "links": {},
+  "meta": {
+    "total": 2
+  }
+}`
+
+var actionExample = `{
+  "action": {
+    "id": 36804636,
+    "status": "completed",
+    "type": "create",
+    "started_at": "2014-11-03T19:03:29Z",
+    "completed_at": "2014-11-03T19:03:55Z",
+    "resource_id": 3164444,
+    "resource_type": "droplet",
+    "region": {
+      "name": "New York 3",
+      "slug": "nyc3",
+      "available": true
+    },
+    "region_slug": "nyc3"
+  }
+}`
+
+var actionErroredExample = `{
+  "action": {
+    "id": 36804636,
+    "status": "errored",
+    "type": "resize",
+    "started_at": "2014-11-03T19:03:29Z",
+    "completed_at": "2014-11-03T19:04:12Z",
+    "resource_id": 3164444,
+    "resource_type": "droplet",
+    "region": "nyc3",
+    "region_slug": "nyc3"
+  }
+}`
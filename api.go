@@ -2,6 +2,7 @@ package digitalocean
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"sync"
 )
 
 // Client provides a client to the DigitalOcean API
@@ -22,13 +24,33 @@ type Client struct {
 	// HttpClient is the client to use. Default will be
 	// used if not provided.
 	Http *http.Client
+
+	// Retry is the policy used to retry failed requests. No
+	// retries are made if it is nil.
+	Retry *RetryPolicy
+
+	// Limiter throttles requests client side. Requests are sent
+	// as fast as they are made if it is nil.
+	Limiter *RateLimiter
+
+	// ValidateDroplets makes CreateDroplet check its parameters
+	// with ValidateCreateDroplet before sending the request
+	ValidateDroplets bool
+
+	// Middleware wraps every attempt at a request, retries included.
+	// The first middleware is the outermost one.
+	Middleware []Middleware
+
+	rateMu sync.Mutex
+	rate   Rate
 }
 
 // DoError is the error format that they return
 // to us if there is a problem
 type DoError struct {
-	Id      string `json:"id"`
-	Message string `json:"message"`
+	Id        string `json:"id"`
+	Message   string `json:"message"`
+	RequestId string `json:"request_id"`
 }
 
 // NewClient returns a new digitalocean client,
@@ -51,23 +73,29 @@ func NewClient(token string) (*Client, error) {
 
 // Creates a new request with the params
 func (c *Client) NewRequest(body interface{}, method string, endpoint string) (*http.Request, error) {
+	return c.NewRequestContext(context.Background(), body, method, endpoint)
+}
+
+// NewRequestContext creates a new request with the params, bound to ctx
+// so that cancellation and deadlines propagate into the HTTP call
+func (c *Client) NewRequestContext(ctx context.Context, body interface{}, method string, endpoint string) (*http.Request, error) {
 	u, err := url.Parse(c.URL + endpoint)
 
 	if err != nil {
-		return nil, fmt.Errorf("Error parsing base URL: %s", err)
+		return nil, fmt.Errorf("Error parsing base URL: %w", err)
 	}
 
 	rBody, err := encodeBody(body)
 
 	if err != nil {
-		return nil, fmt.Errorf("Error encoding request body: %s", err)
+		return nil, fmt.Errorf("Error encoding request body: %w", err)
 	}
 
 	// Build the request
-	req, err := http.NewRequest(method, u.String(), rBody)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), rBody)
 
 	if err != nil {
-		return nil, fmt.Errorf("Error creating request: %s", err)
+		return nil, fmt.Errorf("Error creating request: %w", err)
 	}
 
 	// Add the authorization header
@@ -93,19 +121,35 @@ func encodeBody(obj interface{}) (io.Reader, error) {
 	return buf, nil
 }
 
-// parseErr is used to take an error json resp
-// and return a single string for use in error messages
+// parseErr is used to take an error json resp and turn it
+// into an APIError. The body is decoded on a best effort basis,
+// so the status is always available even if the body isn't JSON.
 func parseErr(resp *http.Response) error {
+	defer resp.Body.Close()
+
 	errBody := new(DoError)
 
-	err := decodeBody(resp, &errBody)
+	// An undecodable body leaves errBody empty, which is fine
+	decodeBody(resp, &errBody)
 
-	// if there was an error decoding the body, just return that
-	if err != nil {
-		return fmt.Errorf("Error parsing error body for non-200 request: %s", err)
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Id:         errBody.Id,
+		Message:    errBody.Message,
+		RequestId:  errBody.RequestId,
+	}
+
+	if id := resp.Header.Get("X-Request-Id"); id != "" {
+		apiErr.RequestId = id
+	}
+
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.Endpoint = resp.Request.URL.Path
 	}
 
-	return fmt.Errorf("API Error: %s: %s", errBody.Id, errBody.Message)
+	return apiErr
 }
 
 // decodeBody is used to JSON decode a body
@@ -123,9 +167,25 @@ func decodeBody(resp *http.Response, out interface{}) error {
 	return nil
 }
 
+// send makes a single attempt at a request, throttling it first
+// and recording the rate limit state of the response
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if err := c.throttle(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.roundTrip()(req)
+
+	if err == nil {
+		c.updateRate(resp)
+	}
+
+	return resp, err
+}
+
 // checkResp wraps http.Client.Do() and verifies that the
-// request was successful. A non-200 request returns an error
-// formatted to included any validation problems or otherwise
+// request was successful. A non-200 request returns an *APIError
+// including any validation problems or otherwise
 func checkResp(resp *http.Response, err error) (*http.Response, error) {
 	// If the err is already there, there was an error higher
 	// up the chain, so just return that
@@ -142,11 +202,7 @@ func checkResp(resp *http.Response, err error) (*http.Response, error) {
 		return resp, nil
 	case i == 204:
 		return resp, nil
-	case i == 422:
-		return nil, parseErr(resp)
-	case i == 400:
-		return nil, parseErr(resp)
 	default:
-		return nil, fmt.Errorf("API Error: %s", resp.Status)
+		return nil, parseErr(resp)
 	}
 }
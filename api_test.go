@@ -1,6 +1,7 @@
 package digitalocean
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -82,3 +83,19 @@ func TestClient_NewRequest(t *testing.T) {
 		t.Fatalf("bad method: %v", req.Method)
 	}
 }
+
+func TestClient_NewRequestContext(t *testing.T) {
+	c := makeClient(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, err := c.NewRequestContext(ctx, nil, "GET", "/bar")
+	if err != nil {
+		t.Fatalf("bad: %v", err)
+	}
+
+	if req.Context() != ctx {
+		t.Fatalf("context not set on request")
+	}
+}
@@ -0,0 +1,176 @@
+package digitalocean
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// redacted replaces secrets in logged requests and responses
+const redacted = "[REDACTED]"
+
+// redactedFields are the JSON fields whose values are never logged
+var redactedFields = map[string]bool{
+	"user_data":  true,
+	"public_key": true,
+}
+
+// DebugLogging returns a middleware that logs every request at the
+// debug level to the logger specified, or to the default logger if it
+// is nil. The method, endpoint, status, latency, request ID, headers
+// and bodies are logged, with the Authorization header, user data and
+// SSH public keys redacted.
+func DebugLogging(logger *slog.Logger) Middleware {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+
+			if !logger.Enabled(ctx, slog.LevelDebug) {
+				return next(req)
+			}
+
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("endpoint", req.URL.Path),
+				slog.Any("headers", redactHeader(req.Header)),
+			}
+
+			if body := requestBody(req); len(body) > 0 {
+				attrs = append(attrs, slog.String("request_body", redactBody(body)))
+			}
+
+			start := time.Now()
+
+			resp, err := next(req)
+
+			attrs = append(attrs, slog.Duration("latency", time.Since(start)))
+
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+				logger.LogAttrs(ctx, slog.LevelDebug, "digitalocean request failed", attrs...)
+				return resp, err
+			}
+
+			body, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+
+			if readErr != nil {
+				attrs = append(attrs, slog.String("error", readErr.Error()))
+			}
+
+			attrs = append(attrs,
+				slog.Int("status", resp.StatusCode),
+				slog.String("request_id", responseRequestId(resp, body)),
+			)
+
+			if len(body) > 0 {
+				attrs = append(attrs, slog.String("response_body", redactBody(body)))
+			}
+
+			logger.LogAttrs(ctx, slog.LevelDebug, "digitalocean request", attrs...)
+
+			return resp, nil
+		}
+	}
+}
+
+// requestBody returns a copy of the body of the request, leaving the
+// request itself untouched. The null body NewRequest encodes for
+// requests without parameters is left out.
+func requestBody(req *http.Request) []byte {
+	if req.Body == nil || req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	b, _ := io.ReadAll(body)
+	b = bytes.TrimSpace(b)
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	return b
+}
+
+// responseRequestId returns the ID of the request from the
+// X-Request-Id header, or the body of an error response
+func responseRequestId(resp *http.Response, body []byte) string {
+	if id := resp.Header.Get("X-Request-Id"); id != "" {
+		return id
+	}
+
+	errBody := new(DoError)
+	json.Unmarshal(body, errBody)
+
+	return errBody.RequestId
+}
+
+// redactHeader returns the headers to log, without the token
+func redactHeader(h http.Header) map[string]string {
+	headers := make(map[string]string, len(h))
+
+	for k, v := range h {
+		if len(v) > 0 {
+			headers[k] = v[0]
+		}
+	}
+
+	if _, ok := headers["Authorization"]; ok {
+		headers["Authorization"] = "Bearer " + redacted
+	}
+
+	return headers
+}
+
+// redactBody returns the body to log, with the values of redacted
+// fields replaced at any depth. Bodies that aren't JSON are logged
+// as they are.
+func redactBody(body []byte) string {
+	var v interface{}
+
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+
+	b, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return string(body)
+	}
+
+	return string(b)
+}
+
+// redactValue replaces the values of redacted fields in a decoded
+// JSON value
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			if redactedFields[k] {
+				v[k] = redacted
+			} else {
+				v[k] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,103 @@
+package digitalocean
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestDebugLog(t *testing.T) {
+	TestingT(t)
+}
+
+// debugLogger returns a logger writing JSON debug records to buf
+func debugLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+func (s *S) Test_DebugLogging(c *C) {
+	testServer.Response(202, map[string]string{"X-Request-Id": "abc-123"}, dropletExample)
+
+	var buf bytes.Buffer
+	client := middlewareClient(c, DebugLogging(debugLogger(&buf)))
+	client.Token = "s3cr3t-token"
+
+	opts := CreateDroplet{
+		Name:     "foobar",
+		UserData: "#!/bin/sh\necho secret",
+	}
+
+	_, err := client.CreateDroplet(&opts)
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(strings.Contains(buf.String(), "s3cr3t-token"), Equals, false)
+	c.Assert(strings.Contains(buf.String(), "echo secret"), Equals, false)
+
+	var entry map[string]interface{}
+	c.Assert(json.Unmarshal(buf.Bytes(), &entry), IsNil)
+	c.Assert(entry["level"], Equals, "DEBUG")
+	c.Assert(entry["method"], Equals, "POST")
+	c.Assert(entry["endpoint"], Equals, "/droplets")
+	c.Assert(entry["status"], Equals, float64(202))
+	c.Assert(entry["request_id"], Equals, "abc-123")
+	c.Assert(entry["latency"], NotNil)
+	c.Assert(entry["headers"].(map[string]interface{})["Authorization"], Equals, "Bearer [REDACTED]")
+	c.Assert(entry["request_body"], Matches, `.*"name":"foobar".*`)
+	c.Assert(entry["request_body"], Matches, `.*"user_data":"\[REDACTED\]".*`)
+	c.Assert(entry["response_body"], Matches, `.*"id":25.*`)
+}
+
+func (s *S) Test_DebugLogging_PublicKey(c *C) {
+	testServer.Response(200, nil, sshKeysSyncExample)
+
+	var buf bytes.Buffer
+	client := middlewareClient(c, DebugLogging(debugLogger(&buf)))
+
+	keys, err := client.RetrieveSSHKeys()
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(keys[0].PublicKey, Equals, publicKeyRSA)
+	c.Assert(strings.Contains(buf.String(), "alice@example.com"), Equals, false)
+	c.Assert(strings.Contains(buf.String(), `\"public_key\":\"[REDACTED]\"`), Equals, true)
+}
+
+func (s *S) Test_DebugLogging_ErrorRequestId(c *C) {
+	testServer.Response(404, nil, `{"id": "not_found", "message": "nope", "request_id": "def-456"}`)
+
+	var buf bytes.Buffer
+	client := middlewareClient(c, DebugLogging(debugLogger(&buf)))
+
+	_, err := client.RetrieveDroplet("25")
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(IsNotFound(err), Equals, true)
+
+	var entry map[string]interface{}
+	c.Assert(json.Unmarshal(buf.Bytes(), &entry), IsNil)
+	c.Assert(entry["status"], Equals, float64(404))
+	c.Assert(entry["request_id"], Equals, "def-456")
+	c.Assert(entry["request_body"], IsNil)
+}
+
+func (s *S) Test_DebugLogging_Disabled(c *C) {
+	testServer.Response(200, nil, dropletExample)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	_, err := middlewareClient(c, DebugLogging(logger)).RetrieveDroplet("25")
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(buf.Len(), Equals, 0)
+}
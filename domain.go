@@ -1,20 +1,28 @@
 package digitalocean
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 )
 
 type DomainResponse struct {
 	Domain Domain `json:"domain"`
 }
 
-// Domain is used to represent a retrieved Domain. All properties
-// are set as strings.
+// Domain is used to represent a retrieved Domain. TTL is the default
+// time to live of its records, in seconds.
 type Domain struct {
 	Name     string `json:"name"`
+	TTL      int    `json:"ttl"`
 	ZoneFile string `json:"zone_file"`
 }
 
+// StringTTL returns the TTL of the domain as a string
+func (d *Domain) StringTTL() string {
+	return strconv.Itoa(d.TTL)
+}
+
 // CreateDomain contains the request parameters to create a new
 // domain.
 type CreateDomain struct {
@@ -26,21 +34,26 @@ type CreateDomain struct {
 // returns an error if it fails. If no error and the name is returned,
 // the Domain was succesfully created.
 func (c *Client) CreateDomain(opts *CreateDomain) (string, error) {
+	return c.CreateDomainContext(context.Background(), opts)
+}
+
+// CreateDomainContext is the context-aware form of CreateDomain.
+func (c *Client) CreateDomainContext(ctx context.Context, opts *CreateDomain) (string, error) {
 	// Make the request parameters
 	params := make(map[string]string)
 
 	params["name"] = opts.Name
 	params["ip_address"] = opts.IPAddress
 
-	req, err := c.NewRequest(params, "POST", "/domains")
+	req, err := c.NewRequestContext(ctx, params, "POST", "/domains")
 	if err != nil {
 		return "", err
 	}
 
-	resp, err := checkResp(c.Http.Do(req))
+	resp, err := checkResp(c.do(req))
 
 	if err != nil {
-		return "", fmt.Errorf("Error creating domain: %s", err)
+		return "", fmt.Errorf("Error creating domain: %w", err)
 	}
 
 	domain := new(DomainResponse)
@@ -48,7 +61,7 @@ func (c *Client) CreateDomain(opts *CreateDomain) (string, error) {
 	err = decodeBody(resp, &domain)
 
 	if err != nil {
-		return "", fmt.Errorf("Error parsing domain response: %s", err)
+		return "", fmt.Errorf("Error parsing domain response: %w", err)
 	}
 
 	// The request was successful
@@ -59,16 +72,21 @@ func (c *Client) CreateDomain(opts *CreateDomain) (string, error) {
 // returns an error if it fails. If no error is returned,
 // the Domain was succesfully destroyed.
 func (c *Client) DestroyDomain(name string) error {
-	req, err := c.NewRequest(map[string]string{}, "DELETE", fmt.Sprintf("/domains/%s", name))
+	return c.DestroyDomainContext(context.Background(), name)
+}
+
+// DestroyDomainContext is the context-aware form of DestroyDomain.
+func (c *Client) DestroyDomainContext(ctx context.Context, name string) error {
+	req, err := c.NewRequestContext(ctx, map[string]string{}, "DELETE", fmt.Sprintf("/domains/%s", name))
 
 	if err != nil {
 		return err
 	}
 
-	_, err = checkResp(c.Http.Do(req))
+	_, err = checkResp(c.do(req))
 
 	if err != nil {
-		return fmt.Errorf("Error destroying domain: %s", err)
+		return fmt.Errorf("Error destroying domain: %w", err)
 	}
 
 	// The request was successful
@@ -79,15 +97,20 @@ func (c *Client) DestroyDomain(name string) error {
 // returns a Domain and an error. An error will be returned for failed
 // requests with a nil Domain.
 func (c *Client) RetrieveDomain(name string) (Domain, error) {
-	req, err := c.NewRequest(map[string]string{}, "GET", fmt.Sprintf("/domains/%s", name))
+	return c.RetrieveDomainContext(context.Background(), name)
+}
+
+// RetrieveDomainContext is the context-aware form of RetrieveDomain.
+func (c *Client) RetrieveDomainContext(ctx context.Context, name string) (Domain, error) {
+	req, err := c.NewRequestContext(ctx, map[string]string{}, "GET", fmt.Sprintf("/domains/%s", name))
 
 	if err != nil {
 		return Domain{}, err
 	}
 
-	resp, err := checkResp(c.Http.Do(req))
+	resp, err := checkResp(c.do(req))
 	if err != nil {
-		return Domain{}, fmt.Errorf("Error destroying domain: %s", err)
+		return Domain{}, fmt.Errorf("Error destroying domain: %w", err)
 	}
 
 	domain := new(DomainResponse)
@@ -95,9 +118,38 @@ func (c *Client) RetrieveDomain(name string) (Domain, error) {
 	err = decodeBody(resp, domain)
 
 	if err != nil {
-		return Domain{}, fmt.Errorf("Error decoding domain response: %s", err)
+		return Domain{}, fmt.Errorf("Error decoding domain response: %w", err)
 	}
 
 	// The request was successful
 	return domain.Domain, nil
 }
+
+// RetrieveDomains gets the list of all domains, following every page,
+// and an error. An error will be returned for failed requests with
+// a nil slice.
+func (c *Client) RetrieveDomains() ([]Domain, error) {
+	return c.RetrieveDomainsContext(context.Background())
+}
+
+// RetrieveDomainsContext is the context-aware form of RetrieveDomains.
+func (c *Client) RetrieveDomainsContext(ctx context.Context) ([]Domain, error) {
+	domains, err := collect(c.IterateDomainsContext(ctx))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving domains: %w", err)
+	}
+
+	// The request was successful
+	return domains, nil
+}
+
+// IterateDomains returns an Iterator over all domains.
+func (c *Client) IterateDomains() *Iterator[Domain] {
+	return c.IterateDomainsContext(context.Background())
+}
+
+// IterateDomainsContext is the context-aware form of IterateDomains.
+func (c *Client) IterateDomainsContext(ctx context.Context) *Iterator[Domain] {
+	return newIterator[Domain](ctx, c, "/domains", "domains")
+}
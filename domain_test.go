@@ -34,9 +34,42 @@ func (s *S) Test_RetrieveDomain(c *C) {
 
 	c.Assert(err, IsNil)
 	c.Assert(domain.Name, Equals, "example.com")
+	c.Assert(domain.TTL, Equals, 1800)
+	c.Assert(domain.StringTTL(), Equals, "1800")
 	c.Assert(domain.ZoneFile, Equals, "")
 }
 
+func (s *S) Test_RetrieveDomains(c *C) {
+	testServer.Response(200, nil, domainsPageOneExample)
+	testServer.Response(200, nil, domainsPageTwoExample)
+
+	domains, err := s.client.RetrieveDomains()
+
+	reqs := testServer.WaitRequests(2)
+
+	c.Assert(err, IsNil)
+	c.Assert(reqs[0].URL.Path, Equals, "/domains")
+	c.Assert(reqs[1].URL.Query().Get("page"), Equals, "2")
+	c.Assert(domains, HasLen, 2)
+	c.Assert(domains[0].Name, Equals, "example.com")
+	c.Assert(domains[0].TTL, Equals, 1800)
+	c.Assert(domains[1].Name, Equals, "example.org")
+	c.Assert(domains[1].TTL, Equals, 3600)
+}
+
+func (s *S) Test_IterateDomains(c *C) {
+	testServer.Response(200, nil, domainsPageOneExample)
+
+	it := s.client.IterateDomains()
+
+	c.Assert(it.Next(), Equals, true)
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(it.Value().Name, Equals, "example.com")
+	c.Assert(it.Total(), Equals, 2)
+}
+
 func (s *S) Test_DestroyDomain(c *C) {
 	testServer.Response(204, nil, "")
 
@@ -59,3 +92,41 @@ var domainExample = `{
     "zone_file": ""
   }
 }`
+
+var domainsPageOneExample = `{
+  "domains": [
+    {
+      "name": "example.com",
+      "ttl": 1800,
+      "zone_file": ""
+    }
+  ],
+  "links": {
+    "pages": {
+      "last": "http://localhost:4444/domains?page=2&per_page=1",
+      "next": "http://localhost:4444/domains?page=2&per_page=1"
+    }
+  },
+  "meta": {
+    "total": 2
+  }
+}`
+
+var domainsPageTwoExample = `{
+  "domains": [
+    {
+      "name": "example.org",
+      "ttl": 3600,
+      "zone_file": ""
+    }
+  ],
+  "links": {
+    "pages": {
+      "first": "http://localhost:4444/domains?page=1&per_page=1",
+      "prev": "http://localhost:4444/domains?page=1&per_page=1"
+    }
+  },
+  "meta": {
+    "total": 2
+  }
+}`
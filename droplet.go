@@ -1,10 +1,16 @@
 package digitalocean
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strconv"
 )
 
+// ErrDropletLimit is wrapped by the error CreateDroplets returns when
+// the droplets would take the account over its droplet limit
+var ErrDropletLimit = errors.New("droplet limit exceeded")
+
 type DropletsResponse struct {
 	Droplets []Droplet `json:"droplets"`
 }
@@ -16,23 +22,41 @@ type DropletResponse struct {
 // Droplet is used to represent a retrieved Droplet. All properties
 // are set as strings.
 type Droplet struct {
-	Id       int64                               `json:"id"`
-	Name     string                              `json:"name"`
-	Region   map[string]interface{}              `json:"region"`
-	Image    map[string]interface{}              `json:"image"`
-	SizeSlug string                              `json:"size_slug"`
-	Locked   bool                                `json:"locked"`
-	Status   string                              `json:"status"`
-	Networks map[string][]map[string]interface{} `json:"networks"`
+	Id       int64    `json:"id"`
+	Name     string   `json:"name"`
+	Region   Region   `json:"region"`
+	Image    Image    `json:"image"`
+	SizeSlug string   `json:"size_slug"`
+	Locked   bool     `json:"locked"`
+	Status   string   `json:"status"`
+	Networks Networks `json:"networks"`
+}
+
+// Networks holds the network interfaces of a Droplet
+type Networks struct {
+	V4 []NetworkV4 `json:"v4"`
+	V6 []NetworkV6 `json:"v6"`
+}
+
+// NetworkV4 is an IPv4 interface of a Droplet
+type NetworkV4 struct {
+	IPAddress string `json:"ip_address"`
+	Netmask   string `json:"netmask"`
+	Gateway   string `json:"gateway"`
+	Type      string `json:"type"`
+}
+
+// NetworkV6 is an IPv6 interface of a Droplet
+type NetworkV6 struct {
+	IPAddress string `json:"ip_address"`
+	Netmask   int    `json:"netmask"`
+	Gateway   string `json:"gateway"`
+	Type      string `json:"type"`
 }
 
 // Returns the slug for the region
 func (d *Droplet) RegionSlug() string {
-	if _, ok := d.Region["slug"]; ok {
-		return d.Region["slug"].(string)
-	}
-
-	return ""
+	return d.Region.Slug
 }
 
 // Returns the slug for the region
@@ -47,32 +71,23 @@ func (d *Droplet) IsLocked() string {
 
 // Returns the slug for the image
 func (d *Droplet) ImageSlug() string {
-	if _, ok := d.Image["slug"]; ok {
-		if attr, ok := d.Image["slug"].(string); ok {
-			return attr
-		}
-	}
-
-	return ""
+	return d.Image.Slug
 }
 
+// Returns the ID of the image
 func (d *Droplet) ImageId() string {
-	if _, ok := d.Image["id"]; ok {
-		if attr, ok := d.Image["id"].(float64); ok {
-			return strconv.FormatFloat(attr, 'f', 0, 64)
-		}
+	if d.Image.Id == 0 {
+		return ""
 	}
 
-	return ""
+	return strconv.FormatInt(d.Image.Id, 10)
 }
 
 // Returns the ipv4 address
 func (d *Droplet) IPV4Address(addressType string) string {
-	if _, ok := d.Networks["v4"]; ok {
-		for _, v := range d.Networks["v4"] {
-			if v["type"].(string) == addressType {
-				return v["ip_address"].(string)
-			}
+	for _, v := range d.Networks.V4 {
+		if v.Type == addressType {
+			return v.IPAddress
 		}
 	}
 	return ""
@@ -80,11 +95,20 @@ func (d *Droplet) IPV4Address(addressType string) string {
 
 // Returns the ipv6 adddress
 func (d *Droplet) IPV6Address(addressType string) string {
-	if _, ok := d.Networks["v6"]; ok {
-		for _, v := range d.Networks["v6"] {
-			if v["type"].(string) == addressType {
-				return v["ip_address"].(string)
-			}
+	for _, v := range d.Networks.V6 {
+		if v.Type == addressType {
+			return v.IPAddress
+		}
+	}
+	return ""
+}
+
+// Returns the address of the floating IP pointing at the droplet,
+// looked up in a list from RetrieveFloatingIPs, or an empty string
+func (d *Droplet) FloatingIP(ips []FloatingIP) string {
+	for _, ip := range ips {
+		if ip.Droplet != nil && ip.Droplet.Id == d.Id {
+			return ip.IP
 		}
 	}
 	return ""
@@ -112,22 +136,34 @@ type CreateDroplet struct {
 	IPV6              bool     `json:"ipv6,omitempty"`               // true or false if IPV6 is enabled
 	PrivateNetworking bool     `json:"private_networking,omitempty"` // true or false if Private Networking is enabled
 	UserData          string   `json:"user_data,omitempty"`          // metadata for the droplet
+	Volumes           []string `json:"volumes,omitempty"`            // Array of Volume IDs to attach to the droplet
 }
 
 // CreateDroplet creates a droplet from the parameters specified and
 // returns an error if it fails. If no error and an ID is returned,
 // the Droplet was succesfully created.
 func (c *Client) CreateDroplet(opts *CreateDroplet) (string, error) {
-	req, err := c.NewRequest(opts, "POST", "/droplets")
+	return c.CreateDropletContext(context.Background(), opts)
+}
+
+// CreateDropletContext is the context-aware form of CreateDroplet.
+func (c *Client) CreateDropletContext(ctx context.Context, opts *CreateDroplet) (string, error) {
+	if c.ValidateDroplets {
+		if err := c.ValidateCreateDropletContext(ctx, opts); err != nil {
+			return "", err
+		}
+	}
+
+	req, err := c.NewRequestContext(ctx, opts, "POST", "/droplets")
 
 	if err != nil {
 		return "", err
 	}
 
-	resp, err := checkResp(c.Http.Do(req))
+	resp, err := checkResp(c.do(req))
 
 	if err != nil {
-		return "", fmt.Errorf("Error creating droplet: %s", err)
+		return "", fmt.Errorf("Error creating droplet: %w", err)
 	}
 
 	droplet := new(DropletResponse)
@@ -135,72 +171,273 @@ func (c *Client) CreateDroplet(opts *CreateDroplet) (string, error) {
 	err = decodeBody(resp, &droplet)
 
 	if err != nil {
-		return "", fmt.Errorf("Error parsing droplet response: %s", err)
+		return "", fmt.Errorf("Error parsing droplet response: %w", err)
 	}
 
 	// The request was successful
 	return droplet.Droplet.StringId(), nil
 }
 
-// DestroyDroplet destroys a droplet by the ID specified and
-// returns an error if it fails. If no error is returned,
-// the Droplet was succesfully destroyed.
-func (c *Client) DestroyDroplet(id string) error {
-	req, err := c.NewRequest(nil, "DELETE", fmt.Sprintf("/droplets/%s", id))
+// CreateDroplets creates a droplet for each of the names specified,
+// all sharing the other parameters, and returns their IDs. Name is
+// ignored. The request is refused before it is sent, with an error
+// wrapping ErrDropletLimit, if it would exceed the account's limit.
+func (c *Client) CreateDroplets(names []string, opts *CreateDroplet) ([]string, error) {
+	return c.CreateDropletsContext(context.Background(), names, opts)
+}
+
+// CreateDropletsContext is the context-aware form of CreateDroplets.
+func (c *Client) CreateDropletsContext(ctx context.Context, names []string, opts *CreateDroplet) ([]string, error) {
+	if opts == nil {
+		opts = &CreateDroplet{}
+	}
+
+	if err := c.checkDropletLimit(ctx, len(names)); err != nil {
+		return nil, err
+	}
+
+	if c.ValidateDroplets {
+		if err := c.ValidateCreateDropletContext(ctx, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	params := *opts
+	params.Name = ""
+
+	body := struct {
+		Names []string `json:"names"`
+		*CreateDroplet
+	}{names, &params}
+
+	req, err := c.NewRequestContext(ctx, body, "POST", "/droplets")
 
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	resp, err := checkResp(c.do(req))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error creating droplets: %w", err)
 	}
 
-	_, err = checkResp(c.Http.Do(req))
+	droplets := new(DropletsResponse)
+
+	err = decodeBody(resp, &droplets)
 
 	if err != nil {
-		return fmt.Errorf("Error destroying droplet: %s", err)
+		return nil, fmt.Errorf("Error parsing droplets response: %w", err)
+	}
+
+	ids := make([]string, 0, len(droplets.Droplets))
+	for _, d := range droplets.Droplets {
+		ids = append(ids, d.StringId())
 	}
 
 	// The request was successful
+	return ids, nil
+}
+
+// checkDropletLimit returns an error if creating n more droplets
+// would exceed the account's droplet limit
+func (c *Client) checkDropletLimit(ctx context.Context, n int) error {
+	account, err := c.RetrieveAccountContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error checking droplet limit: %w", err)
+	}
+
+	// The smallest page is enough to learn the total
+	req, err := c.NewRequestContext(ctx, nil, "GET", "/droplets?per_page=1")
+	if err != nil {
+		return err
+	}
+
+	resp, err := checkResp(c.do(req))
+	if err != nil {
+		return fmt.Errorf("Error checking droplet limit: %w", err)
+	}
+
+	page := struct {
+		Meta Meta `json:"meta"`
+	}{}
+
+	err = decodeBody(resp, &page)
+	resp.Body.Close()
+
+	if err != nil {
+		return fmt.Errorf("Error checking droplet limit: %w", err)
+	}
+
+	if total := page.Meta.Total; total+n > account.DropletLimit {
+		return fmt.Errorf("Error creating droplets: %d existing and %d new droplets exceed the limit of %d: %w",
+			total, n, account.DropletLimit, ErrDropletLimit)
+	}
+
 	return nil
 }
 
-// RetrieveDroplets gets the list of Droplets and an error. An error will
-// be returned for failed requests with a nil slice.
-func (c *Client) RetrieveDroplets() ([]Droplet, error) {
-	req, err := c.NewRequest(map[string]string{}, "GET", "/droplets")
+// ValidateCreateDroplet checks that the region, size and image of the
+// parameters exist and can be used together, so an impossible request
+// fails before it is sent. Parameters that aren't set are not checked.
+func (c *Client) ValidateCreateDroplet(opts *CreateDroplet) error {
+	return c.ValidateCreateDropletContext(context.Background(), opts)
+}
+
+// ValidateCreateDropletContext is the context-aware form of ValidateCreateDroplet.
+func (c *Client) ValidateCreateDropletContext(ctx context.Context, opts *CreateDroplet) error {
+	var region *Region
+	var size *Size
+
+	if opts.Region != "" {
+		regions, err := c.RetrieveRegionsContext(ctx)
+		if err != nil {
+			return fmt.Errorf("Error validating droplet: %w", err)
+		}
+
+		for i := range regions {
+			if regions[i].Slug == opts.Region {
+				region = &regions[i]
+			}
+		}
+
+		if region == nil {
+			return fmt.Errorf("Error validating droplet: region %s does not exist", opts.Region)
+		}
+
+		if !region.Available {
+			return fmt.Errorf("Error validating droplet: region %s is not available", opts.Region)
+		}
+	}
+
+	if opts.Size != "" {
+		sizes, err := c.RetrieveSizesContext(ctx)
+		if err != nil {
+			return fmt.Errorf("Error validating droplet: %w", err)
+		}
+
+		for i := range sizes {
+			if sizes[i].Slug == opts.Size {
+				size = &sizes[i]
+			}
+		}
+
+		if size == nil {
+			return fmt.Errorf("Error validating droplet: size %s does not exist", opts.Size)
+		}
+
+		if !size.Available {
+			return fmt.Errorf("Error validating droplet: size %s is not available", opts.Size)
+		}
+
+		if region != nil && !contains(region.Sizes, size.Slug) {
+			return fmt.Errorf("Error validating droplet: size %s is not available in region %s", size.Slug, region.Slug)
+		}
+	}
+
+	if opts.Image != "" {
+		image, err := c.RetrieveImageContext(ctx, opts.Image)
+		if err != nil {
+			return fmt.Errorf("Error validating droplet: %w", err)
+		}
+
+		if region != nil && len(image.Regions) > 0 && !contains(image.Regions, region.Slug) {
+			return fmt.Errorf("Error validating droplet: image %s is not available in region %s", opts.Image, region.Slug)
+		}
+
+		if size != nil && image.MinDiskSize > size.Disk {
+			return fmt.Errorf("Error validating droplet: image %s needs a disk of at least %dGB, size %s has %dGB",
+				opts.Image, image.MinDiskSize, size.Slug, size.Disk)
+		}
+	}
+
+	return nil
+}
+
+// contains returns whether the slice holds the string
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DestroyDroplet destroys a droplet by the ID specified and
+// returns an error if it fails. If no error is returned,
+// the Droplet was succesfully destroyed.
+func (c *Client) DestroyDroplet(id string) error {
+	return c.DestroyDropletContext(context.Background(), id)
+}
+
+// DestroyDropletContext is the context-aware form of DestroyDroplet.
+func (c *Client) DestroyDropletContext(ctx context.Context, id string) error {
+	req, err := c.NewRequestContext(ctx, nil, "DELETE", fmt.Sprintf("/droplets/%s", id))
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	resp, err := checkResp(c.Http.Do(req))
+	_, err = checkResp(c.do(req))
+
 	if err != nil {
-		return nil, fmt.Errorf("Error retrieving droplets: %s", err)
+		return fmt.Errorf("Error destroying droplet: %w", err)
 	}
 
-	droplets := new(DropletsResponse)
+	// The request was successful
+	return nil
+}
 
-	err = decodeBody(resp, droplets)
+// RetrieveDroplets gets the list of all Droplets, following every
+// page, and an error. An error will be returned for failed requests
+// with a nil slice.
+func (c *Client) RetrieveDroplets() ([]Droplet, error) {
+	return c.RetrieveDropletsContext(context.Background())
+}
+
+// RetrieveDropletsContext is the context-aware form of RetrieveDroplets.
+func (c *Client) RetrieveDropletsContext(ctx context.Context) ([]Droplet, error) {
+	droplets, err := collect(c.IterateDropletsContext(ctx))
 
 	if err != nil {
-		return nil, fmt.Errorf("Error decoding droplet response: %s", err)
+		return nil, fmt.Errorf("Error retrieving droplets: %w", err)
 	}
 
 	// The request was successful
-	return droplets.Droplets, nil
+	return droplets, nil
+}
+
+// IterateDroplets returns an Iterator over all Droplets, fetching
+// them a page at a time.
+func (c *Client) IterateDroplets() *Iterator[Droplet] {
+	return c.IterateDropletsContext(context.Background())
+}
+
+// IterateDropletsContext is the context-aware form of IterateDroplets.
+func (c *Client) IterateDropletsContext(ctx context.Context) *Iterator[Droplet] {
+	return newIterator[Droplet](ctx, c, "/droplets", "droplets")
 }
 
 // RetrieveDroplet gets  a droplet by the ID specified and
 // returns a Droplet and an error. An error will be returned for failed
 // requests with a nil Droplet.
 func (c *Client) RetrieveDroplet(id string) (Droplet, error) {
-	req, err := c.NewRequest(nil, "GET", fmt.Sprintf("/droplets/%s", id))
+	return c.RetrieveDropletContext(context.Background(), id)
+}
+
+// RetrieveDropletContext is the context-aware form of RetrieveDroplet.
+func (c *Client) RetrieveDropletContext(ctx context.Context, id string) (Droplet, error) {
+	req, err := c.NewRequestContext(ctx, nil, "GET", fmt.Sprintf("/droplets/%s", id))
 
 	if err != nil {
 		return Droplet{}, err
 	}
 
-	resp, err := checkResp(c.Http.Do(req))
+	resp, err := checkResp(c.do(req))
 	if err != nil {
-		return Droplet{}, fmt.Errorf("Error retrieving droplet: %s", err)
+		return Droplet{}, fmt.Errorf("Error retrieving droplet: %w", err)
 	}
 
 	droplet := new(DropletResponse)
@@ -208,71 +445,351 @@ func (c *Client) RetrieveDroplet(id string) (Droplet, error) {
 	err = decodeBody(resp, droplet)
 
 	if err != nil {
-		return Droplet{}, fmt.Errorf("Error decoding droplet response: %s", err)
+		return Droplet{}, fmt.Errorf("Error decoding droplet response: %w", err)
 	}
 
 	// The request was successful
 	return droplet.Droplet, nil
 }
 
-// Action sends the specified action to the droplet. An error
-// is retunred, and is nil if successful
-func (c *Client) Action(id string, action map[string]interface{}) error {
-	req, err := c.NewRequest(action, "POST", fmt.Sprintf("/droplets/%s/actions", id))
+// Action sends the specified action to the droplet and returns
+// the resulting Action, which can be passed to WaitForAction. An
+// error is retunred, and is nil if successful
+func (c *Client) Action(id string, action map[string]interface{}) (Action, error) {
+	return c.ActionContext(context.Background(), id, action)
+}
+
+// ActionContext is the context-aware form of Action.
+func (c *Client) ActionContext(ctx context.Context, id string, action map[string]interface{}) (Action, error) {
+	req, err := c.NewRequestContext(ctx, action, "POST", fmt.Sprintf("/droplets/%s/actions", id))
 
 	if err != nil {
-		return err
+		return Action{}, err
+	}
+
+	resp, err := checkResp(c.do(req))
+	if err != nil {
+		return Action{}, fmt.Errorf("Error processing droplet action: %w", err)
 	}
 
-	_, err = checkResp(c.Http.Do(req))
+	return decodeAction(resp)
+}
+
+// RetrieveDropletActions gets the history of actions taken on the
+// droplet by the ID specified, following every page, and an error.
+// An error will be returned for failed requests with a nil slice.
+func (c *Client) RetrieveDropletActions(id string) ([]Action, error) {
+	return c.RetrieveDropletActionsContext(context.Background(), id)
+}
+
+// RetrieveDropletActionsContext is the context-aware form of RetrieveDropletActions.
+func (c *Client) RetrieveDropletActionsContext(ctx context.Context, id string) ([]Action, error) {
+	actions, err := collect(c.IterateDropletActionsContext(ctx, id))
+
 	if err != nil {
-		return fmt.Errorf("Error processing droplet action: %s", err)
+		return nil, fmt.Errorf("Error retrieving droplet actions: %w", err)
 	}
 
 	// The request was successful
-	return nil
+	return actions, nil
+}
+
+// IterateDropletActions returns an Iterator over the actions taken
+// on the droplet by the ID specified.
+func (c *Client) IterateDropletActions(id string) *Iterator[Action] {
+	return c.IterateDropletActionsContext(context.Background(), id)
+}
+
+// IterateDropletActionsContext is the context-aware form of IterateDropletActions.
+func (c *Client) IterateDropletActionsContext(ctx context.Context, id string) *Iterator[Action] {
+	return newIterator[Action](ctx, c, fmt.Sprintf("/droplets/%s/actions", id), "actions")
+}
+
+// RetrieveDropletSnapshots gets the snapshots of the droplet by the
+// ID specified, following every page, and an error. An error will be
+// returned for failed requests with a nil slice.
+func (c *Client) RetrieveDropletSnapshots(id string) ([]Image, error) {
+	return c.RetrieveDropletSnapshotsContext(context.Background(), id)
+}
+
+// RetrieveDropletSnapshotsContext is the context-aware form of RetrieveDropletSnapshots.
+func (c *Client) RetrieveDropletSnapshotsContext(ctx context.Context, id string) ([]Image, error) {
+	snapshots, err := collect(c.IterateDropletSnapshotsContext(ctx, id))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving droplet snapshots: %w", err)
+	}
+
+	// The request was successful
+	return snapshots, nil
+}
+
+// IterateDropletSnapshots returns an Iterator over the snapshots
+// of the droplet by the ID specified.
+func (c *Client) IterateDropletSnapshots(id string) *Iterator[Image] {
+	return c.IterateDropletSnapshotsContext(context.Background(), id)
+}
+
+// IterateDropletSnapshotsContext is the context-aware form of IterateDropletSnapshots.
+func (c *Client) IterateDropletSnapshotsContext(ctx context.Context, id string) *Iterator[Image] {
+	return newIterator[Image](ctx, c, fmt.Sprintf("/droplets/%s/snapshots", id), "snapshots")
+}
+
+// RetrieveDropletBackups gets the backups of the droplet by the ID
+// specified, following every page, and an error. An error will be
+// returned for failed requests with a nil slice.
+func (c *Client) RetrieveDropletBackups(id string) ([]Image, error) {
+	return c.RetrieveDropletBackupsContext(context.Background(), id)
+}
+
+// RetrieveDropletBackupsContext is the context-aware form of RetrieveDropletBackups.
+func (c *Client) RetrieveDropletBackupsContext(ctx context.Context, id string) ([]Image, error) {
+	backups, err := collect(c.IterateDropletBackupsContext(ctx, id))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving droplet backups: %w", err)
+	}
+
+	// The request was successful
+	return backups, nil
+}
+
+// IterateDropletBackups returns an Iterator over the backups of
+// the droplet by the ID specified.
+func (c *Client) IterateDropletBackups(id string) *Iterator[Image] {
+	return c.IterateDropletBackupsContext(context.Background(), id)
+}
+
+// IterateDropletBackupsContext is the context-aware form of IterateDropletBackups.
+func (c *Client) IterateDropletBackupsContext(ctx context.Context, id string) *Iterator[Image] {
+	return newIterator[Image](ctx, c, fmt.Sprintf("/droplets/%s/backups", id), "backups")
 }
 
 // Resizes a droplet to the size slug specified
-func (c *Client) Resize(id string, size string) error {
-	return c.Action(id, map[string]interface{}{
+func (c *Client) Resize(id string, size string) (Action, error) {
+	return c.ResizeContext(context.Background(), id, size)
+}
+
+// ResizeContext is the context-aware form of Resize.
+func (c *Client) ResizeContext(ctx context.Context, id string, size string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
 		"type": "resize",
 		"size": size,
 	})
 }
 
 // Renames a droplet to the name specified
-func (c *Client) Rename(id string, name string) error {
-	return c.Action(id, map[string]interface{}{
+func (c *Client) Rename(id string, name string) (Action, error) {
+	return c.RenameContext(context.Background(), id, name)
+}
+
+// RenameContext is the context-aware form of Rename.
+func (c *Client) RenameContext(ctx context.Context, id string, name string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
 		"type": "rename",
 		"name": name,
 	})
 }
 
 // Enables IPV6 on the droplet
-func (c *Client) EnableIPV6s(id string) error {
-	return c.Action(id, map[string]interface{}{
+func (c *Client) EnableIPV6s(id string) (Action, error) {
+	return c.EnableIPV6sContext(context.Background(), id)
+}
+
+// EnableIPV6sContext is the context-aware form of EnableIPV6s.
+func (c *Client) EnableIPV6sContext(ctx context.Context, id string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
 		"type": "enable_ipv6",
 	})
 }
 
 // Enables private networking on the droplet
-func (c *Client) EnablePrivateNetworking(id string) error {
-	return c.Action(id, map[string]interface{}{
+func (c *Client) EnablePrivateNetworking(id string) (Action, error) {
+	return c.EnablePrivateNetworkingContext(context.Background(), id)
+}
+
+// EnablePrivateNetworkingContext is the context-aware form of EnablePrivateNetworking.
+func (c *Client) EnablePrivateNetworkingContext(ctx context.Context, id string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
 		"type": "enable_private_networking",
 	})
 }
 
-// Resizes a droplet to the size slug specified
-func (c *Client) PowerOff(id string) error {
-	return c.Action(id, map[string]interface{}{
+// Powers off the droplet
+func (c *Client) PowerOff(id string) (Action, error) {
+	return c.PowerOffContext(context.Background(), id)
+}
+
+// PowerOffContext is the context-aware form of PowerOff.
+func (c *Client) PowerOffContext(ctx context.Context, id string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
 		"type": "power_off",
 	})
 }
 
-// Resizes a droplet to the size slug specified
-func (c *Client) PowerOn(id string) error {
-	return c.Action(id, map[string]interface{}{
+// Powers on the droplet
+func (c *Client) PowerOn(id string) (Action, error) {
+	return c.PowerOnContext(context.Background(), id)
+}
+
+// PowerOnContext is the context-aware form of PowerOn.
+func (c *Client) PowerOnContext(ctx context.Context, id string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
 		"type": "power_on",
 	})
 }
+
+// Reboots the droplet
+func (c *Client) Reboot(id string) (Action, error) {
+	return c.RebootContext(context.Background(), id)
+}
+
+// RebootContext is the context-aware form of Reboot.
+func (c *Client) RebootContext(ctx context.Context, id string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
+		"type": "reboot",
+	})
+}
+
+// Shuts down the droplet gracefully
+func (c *Client) Shutdown(id string) (Action, error) {
+	return c.ShutdownContext(context.Background(), id)
+}
+
+// ShutdownContext is the context-aware form of Shutdown.
+func (c *Client) ShutdownContext(ctx context.Context, id string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
+		"type": "shutdown",
+	})
+}
+
+// Power cycles the droplet, like a hard reset
+func (c *Client) PowerCycle(id string) (Action, error) {
+	return c.PowerCycleContext(context.Background(), id)
+}
+
+// PowerCycleContext is the context-aware form of PowerCycle.
+func (c *Client) PowerCycleContext(ctx context.Context, id string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
+		"type": "power_cycle",
+	})
+}
+
+// Resets the root password of the droplet, which is emailed
+// to the account owner
+func (c *Client) PasswordReset(id string) (Action, error) {
+	return c.PasswordResetContext(context.Background(), id)
+}
+
+// PasswordResetContext is the context-aware form of PasswordReset.
+func (c *Client) PasswordResetContext(ctx context.Context, id string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
+		"type": "password_reset",
+	})
+}
+
+// Rebuilds the droplet from the image specified, either by slug
+// or by ID
+func (c *Client) Rebuild(id string, image string) (Action, error) {
+	return c.RebuildContext(context.Background(), id, image)
+}
+
+// RebuildContext is the context-aware form of Rebuild.
+func (c *Client) RebuildContext(ctx context.Context, id string, image string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
+		"type":  "rebuild",
+		"image": idParam(image),
+	})
+}
+
+// Restores the droplet from the ID of one of its backups
+// or snapshots
+func (c *Client) Restore(id string, image string) (Action, error) {
+	return c.RestoreContext(context.Background(), id, image)
+}
+
+// RestoreContext is the context-aware form of Restore.
+func (c *Client) RestoreContext(ctx context.Context, id string, image string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
+		"type":  "restore",
+		"image": idParam(image),
+	})
+}
+
+// RestoreAndWait restores the droplet from the ID of one of its
+// backups or snapshots, like Restore, and waits for the restore to
+// complete with WaitForAction.
+func (c *Client) RestoreAndWait(id string, image string, opts *WaitOptions) (Action, error) {
+	return c.RestoreAndWaitContext(context.Background(), id, image, opts)
+}
+
+// RestoreAndWaitContext is the context-aware form of RestoreAndWait.
+func (c *Client) RestoreAndWaitContext(ctx context.Context, id string, image string, opts *WaitOptions) (Action, error) {
+	action, err := c.RestoreContext(ctx, id, image)
+
+	if err != nil {
+		return Action{}, err
+	}
+
+	return c.WaitForActionContext(ctx, action.StringId(), opts)
+}
+
+// Takes a snapshot of the droplet with the name specified
+func (c *Client) Snapshot(id string, name string) (Action, error) {
+	return c.SnapshotContext(context.Background(), id, name)
+}
+
+// SnapshotContext is the context-aware form of Snapshot.
+func (c *Client) SnapshotContext(ctx context.Context, id string, name string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
+		"type": "snapshot",
+		"name": name,
+	})
+}
+
+// Changes the kernel of the droplet to the kernel ID specified
+func (c *Client) ChangeKernel(id string, kernel string) (Action, error) {
+	return c.ChangeKernelContext(context.Background(), id, kernel)
+}
+
+// ChangeKernelContext is the context-aware form of ChangeKernel.
+func (c *Client) ChangeKernelContext(ctx context.Context, id string, kernel string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
+		"type":   "change_kernel",
+		"kernel": idParam(kernel),
+	})
+}
+
+// Enables backups on the droplet
+func (c *Client) EnableBackups(id string) (Action, error) {
+	return c.EnableBackupsContext(context.Background(), id)
+}
+
+// EnableBackupsContext is the context-aware form of EnableBackups.
+func (c *Client) EnableBackupsContext(ctx context.Context, id string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
+		"type": "enable_backups",
+	})
+}
+
+// Disables backups on the droplet
+func (c *Client) DisableBackups(id string) (Action, error) {
+	return c.DisableBackupsContext(context.Background(), id)
+}
+
+// DisableBackupsContext is the context-aware form of DisableBackups.
+func (c *Client) DisableBackupsContext(ctx context.Context, id string) (Action, error) {
+	return c.ActionContext(ctx, id, map[string]interface{}{
+		"type": "disable_backups",
+	})
+}
+
+// idParam sends numeric IDs as JSON numbers, and anything else,
+// like an image slug, as a string
+func idParam(id string) interface{} {
+	if i, err := strconv.ParseInt(id, 10, 64); err == nil {
+		return i
+	}
+
+	return id
+}
@@ -1,9 +1,15 @@
 package digitalocean
 
 import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"strings"
 	"testing"
+	"time"
 
 	. "github.com/motain/gocheck"
+	"github.com/pearkes/digitalocean/testutil"
 )
 
 func TestDroplet(t *testing.T) {
@@ -27,6 +33,144 @@ func (s *S) Test_CreateDroplet(c *C) {
 	c.Assert(id, Equals, "25")
 }
 
+func (s *S) Test_CreateDroplet_volumes(c *C) {
+	testServer.Response(202, nil, dropletExample)
+
+	opts := CreateDroplet{
+		Name:    "foobar",
+		Volumes: []string{"506f78a4-e098-11e5-ad9f-000f53306ae1"},
+	}
+
+	_, err := s.client.CreateDroplet(&opts)
+
+	req := testServer.WaitRequest()
+
+	body, _ := ioutil.ReadAll(req.Body)
+
+	c.Assert(err, IsNil)
+	c.Assert(strings.TrimSpace(string(body)), Equals, `{"name":"foobar","volumes":["506f78a4-e098-11e5-ad9f-000f53306ae1"]}`)
+}
+
+func (s *S) Test_CreateDroplets(c *C) {
+	testServer.Response(200, nil, accountExample)
+	testServer.Response(200, nil, `{"droplets": [], "links": {}, "meta": {"total": 1}}`)
+	testServer.Response(202, nil, dropletsExample)
+
+	opts := CreateDroplet{
+		Name:   "ignored",
+		Region: "nyc1",
+		Size:   "512mb",
+		Image:  "ubuntu-14-04-x64",
+	}
+
+	ids, err := s.client.CreateDroplets([]string{"sub-01", "sub-02"}, &opts)
+
+	reqs := testServer.WaitRequests(3)
+
+	c.Assert(err, IsNil)
+	c.Assert(ids, DeepEquals, []string{"25", "26"})
+	c.Assert(reqs[1].URL.Path, Equals, "/droplets")
+	c.Assert(reqs[1].URL.RawQuery, Equals, "per_page=1")
+	c.Assert(reqs[2].Method, Equals, "POST")
+
+	body, _ := ioutil.ReadAll(reqs[2].Body)
+	c.Assert(strings.TrimSpace(string(body)), Equals, `{"names":["sub-01","sub-02"],"region":"nyc1","size":"512mb","image":"ubuntu-14-04-x64"}`)
+}
+
+func (s *S) Test_CreateDroplets_nilOpts(c *C) {
+	testServer.Response(200, nil, accountExample)
+	testServer.Response(200, nil, `{"droplets": [], "links": {}, "meta": {"total": 1}}`)
+	testServer.Response(202, nil, dropletsExample)
+
+	ids, err := s.client.CreateDroplets([]string{"sub-01", "sub-02"}, nil)
+
+	reqs := testServer.WaitRequests(3)
+
+	c.Assert(err, IsNil)
+	c.Assert(ids, DeepEquals, []string{"25", "26"})
+
+	body, _ := ioutil.ReadAll(reqs[2].Body)
+	c.Assert(strings.TrimSpace(string(body)), Matches, `\{"names":\["sub-01","sub-02"\].*\}`)
+}
+
+func (s *S) Test_CreateDroplets_overLimit(c *C) {
+	testServer.Response(200, nil, accountExample)
+	testServer.Response(200, nil, `{"droplets": [], "links": {}, "meta": {"total": 2}}`)
+
+	_, err := s.client.CreateDroplets([]string{"sub-01", "sub-02"}, &CreateDroplet{})
+
+	_ = testServer.WaitRequests(2)
+
+	c.Assert(err, ErrorMatches, "Error creating droplets: 2 existing and 2 new droplets exceed the limit of 3: droplet limit exceeded")
+	c.Assert(errors.Is(err, ErrDropletLimit), Equals, true)
+}
+
+var validateResponses = testutil.ResponseMap{
+	"/regions":                 testutil.Response{Status: 200, Body: regionsExample},
+	"/sizes":                   testutil.Response{Status: 200, Body: sizesExample},
+	"/images/ubuntu-14-04-x64": testutil.Response{Status: 200, Body: imageExample},
+}
+
+func (s *S) Test_ValidateCreateDroplet(c *C) {
+	testServer.ResponseMap(3, validateResponses)
+
+	err := s.client.ValidateCreateDroplet(&CreateDroplet{
+		Region: "nyc1",
+		Size:   "1gb",
+		Image:  "ubuntu-14-04-x64",
+	})
+
+	_ = testServer.WaitRequests(3)
+
+	c.Assert(err, IsNil)
+}
+
+func (s *S) Test_ValidateCreateDroplet_errors(c *C) {
+	cases := []struct {
+		opts     CreateDroplet
+		requests int
+		err      string
+	}{
+		{CreateDroplet{Region: "lon1"}, 1, "region lon1 does not exist"},
+		{CreateDroplet{Region: "sfo1"}, 1, "region sfo1 is not available"},
+		{CreateDroplet{Size: "64gb"}, 1, "size 64gb does not exist"},
+		{CreateDroplet{Region: "nyc1", Size: "2gb"}, 2, "size 2gb is not available in region nyc1"},
+		{CreateDroplet{Size: "512mb", Image: "ubuntu-14-04-x64"}, 2, "image ubuntu-14-04-x64 needs a disk of at least 30GB, size 512mb has 20GB"},
+	}
+
+	for _, tc := range cases {
+		testServer.ResponseMap(tc.requests, validateResponses)
+
+		err := s.client.ValidateCreateDroplet(&tc.opts)
+
+		_ = testServer.WaitRequests(tc.requests)
+
+		if tc.err == "" {
+			c.Assert(err, IsNil)
+			continue
+		}
+		c.Assert(err, ErrorMatches, "Error validating droplet: "+tc.err)
+	}
+}
+
+func (s *S) Test_CreateDroplet_validated(c *C) {
+	client, err := NewClient("foobar")
+	c.Assert(err, IsNil)
+
+	client.URL = "http://localhost:4444"
+	client.ValidateDroplets = true
+
+	testServer.ResponseMap(1, validateResponses)
+
+	_, err = client.CreateDroplet(&CreateDroplet{Name: "foobar", Region: "sfo1"})
+
+	// Only the region lookup is made, the droplet is never created
+	req := testServer.WaitRequest()
+
+	c.Assert(req.URL.Path, Equals, "/regions")
+	c.Assert(err, ErrorMatches, "Error validating droplet: region sfo1 is not available")
+}
+
 func (s *S) Test_RetrieveDroplets(c *C) {
 	testServer.Response(200, nil, dropletsExample)
 
@@ -92,6 +236,50 @@ func (s *S) Test_RetrieveDroplet_noImage(c *C) {
 	c.Assert(droplet.ImageId(), Equals, "449676389")
 }
 
+func (s *S) Test_RetrieveDroplet_typed(c *C) {
+	testServer.Response(200, nil, dropletExample)
+
+	droplet, err := s.client.RetrieveDroplet("25")
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(droplet.Region.Name, Equals, "New York")
+	c.Assert(droplet.Region.Available, Equals, true)
+	c.Assert(droplet.Region.Sizes, DeepEquals, []string{"1024mb", "512mb"})
+	c.Assert(droplet.Region.Features, HasLen, 4)
+	c.Assert(droplet.Image.Distribution, Equals, "ubuntu")
+	c.Assert(droplet.Image.CreatedAt.Year(), Equals, 2014)
+	c.Assert(droplet.Networks.V4, HasLen, 2)
+	c.Assert(droplet.Networks.V4[1].Gateway, Equals, "127.0.0.21")
+	c.Assert(droplet.Networks.V4[1].Netmask, Equals, "255.255.255.0")
+}
+
+func (s *S) Test_RetrieveDroplet_missingNetworkType(c *C) {
+	testServer.Response(200, nil, `{"droplet": {"id": 25, "networks": {"v4": [{"ip_address": "127.0.0.20"}], "v6": [{"ip_address": "::1", "netmask": 64}]}}}`)
+
+	droplet, err := s.client.RetrieveDroplet("25")
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(droplet.IPV4Address("public"), Equals, "")
+	c.Assert(droplet.IPV6Address("public"), Equals, "")
+	c.Assert(droplet.NetworkingType(), Equals, "public")
+	c.Assert(droplet.RegionSlug(), Equals, "")
+	c.Assert(droplet.ImageId(), Equals, "")
+	c.Assert(droplet.Networks.V6[0].Netmask, Equals, 64)
+}
+
+func (s *S) Test_RetrieveDropletContext_canceled(c *C) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := s.client.RetrieveDropletContext(ctx, "25")
+
+	c.Assert(err, ErrorMatches, "Error retrieving droplet: .*context canceled")
+}
+
 func (s *S) Test_DestroyDroplet(c *C) {
 	testServer.Response(204, nil, "")
 
@@ -105,17 +293,22 @@ func (s *S) Test_DestroyDroplet(c *C) {
 func (s *S) Test_Resize(c *C) {
 	testServer.Response(200, nil, dropletExampleAction)
 
-	err := s.client.Resize("25", "1gb")
+	action, err := s.client.Resize("25", "1gb")
 
 	_ = testServer.WaitRequest()
 
 	c.Assert(err, IsNil)
+	c.Assert(action.StringId(), Equals, "15")
+	c.Assert(action.Status, Equals, "in-progress")
+	c.Assert(action.RegionSlug, Equals, "")
+	c.Assert(action.Region.Slug, Equals, "nyc1")
+	c.Assert(action.CompletedAt.IsZero(), Equals, true)
 }
 
 func (s *S) Test_Rename(c *C) {
 	testServer.Response(200, nil, dropletExampleAction)
 
-	err := s.client.Rename("25", "foobar")
+	_, err := s.client.Rename("25", "foobar")
 
 	_ = testServer.WaitRequest()
 
@@ -125,7 +318,7 @@ func (s *S) Test_Rename(c *C) {
 func (s *S) Test_EnableIPV6s(c *C) {
 	testServer.Response(200, nil, dropletExampleAction)
 
-	err := s.client.EnableIPV6s("25")
+	_, err := s.client.EnableIPV6s("25")
 
 	_ = testServer.WaitRequest()
 
@@ -135,7 +328,7 @@ func (s *S) Test_EnableIPV6s(c *C) {
 func (s *S) Test_EnablePrivateNetworking(c *C) {
 	testServer.Response(200, nil, dropletExampleAction)
 
-	err := s.client.EnablePrivateNetworking("25")
+	_, err := s.client.EnablePrivateNetworking("25")
 
 	_ = testServer.WaitRequest()
 
@@ -145,7 +338,7 @@ func (s *S) Test_EnablePrivateNetworking(c *C) {
 func (s *S) Test_ActionError(c *C) {
 	testServer.Response(422, nil, dropletExampleActionError)
 
-	err := s.client.EnablePrivateNetworking("25")
+	_, err := s.client.EnablePrivateNetworking("25")
 
 	_ = testServer.WaitRequest()
 
@@ -155,7 +348,7 @@ func (s *S) Test_ActionError(c *C) {
 func (s *S) Test_PowerOn(c *C) {
 	testServer.Response(200, nil, dropletExampleAction)
 
-	err := s.client.PowerOn("25")
+	_, err := s.client.PowerOn("25")
 
 	_ = testServer.WaitRequest()
 
@@ -165,13 +358,101 @@ func (s *S) Test_PowerOn(c *C) {
 func (s *S) Test_PowerOff(c *C) {
 	testServer.Response(200, nil, dropletExampleAction)
 
-	err := s.client.PowerOff("25")
+	_, err := s.client.PowerOff("25")
 
 	_ = testServer.WaitRequest()
 
 	c.Assert(err, IsNil)
 }
 
+func (s *S) Test_RetrieveDropletSnapshots(c *C) {
+	testServer.Response(200, nil, `{"snapshots": [`+dropletImageJSON+`], "links": {}, "meta": {"total": 1}}`)
+
+	snapshots, err := s.client.RetrieveDropletSnapshots("25")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/droplets/25/snapshots")
+	c.Assert(snapshots, HasLen, 1)
+	c.Assert(snapshots[0].StringId(), Equals, "7938206")
+	c.Assert(snapshots[0].Type, Equals, "snapshot")
+}
+
+func (s *S) Test_RetrieveDropletBackups(c *C) {
+	testServer.Response(200, nil, `{"backups": [`+dropletImageJSON+`], "links": {}, "meta": {"total": 1}}`)
+
+	backups, err := s.client.RetrieveDropletBackups("25")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/droplets/25/backups")
+	c.Assert(backups, HasLen, 1)
+	c.Assert(backups[0].MinDiskSize, Equals, 20)
+}
+
+func (s *S) Test_RestoreAndWait(c *C) {
+	testServer.Response(201, nil, dropletExampleAction)
+	testServer.Response(200, nil, actionExample)
+
+	action, err := s.client.RestoreAndWait("25", "7938206", &WaitOptions{Interval: time.Millisecond})
+
+	reqs := testServer.WaitRequests(2)
+
+	c.Assert(err, IsNil)
+	c.Assert(action.Status, Equals, "completed")
+	c.Assert(reqs[0].URL.Path, Equals, "/droplets/25/actions")
+	c.Assert(reqs[1].URL.Path, Equals, "/actions/15")
+}
+
+func (s *S) Test_ActionBodies(c *C) {
+	cases := []struct {
+		action func() (Action, error)
+		body   string
+	}{
+		{func() (Action, error) { return s.client.Reboot("25") }, `{"type":"reboot"}`},
+		{func() (Action, error) { return s.client.Shutdown("25") }, `{"type":"shutdown"}`},
+		{func() (Action, error) { return s.client.PowerCycle("25") }, `{"type":"power_cycle"}`},
+		{func() (Action, error) { return s.client.PasswordReset("25") }, `{"type":"password_reset"}`},
+		{func() (Action, error) { return s.client.Rebuild("25", "ubuntu-14-04-x64") }, `{"image":"ubuntu-14-04-x64","type":"rebuild"}`},
+		{func() (Action, error) { return s.client.Rebuild("25", "449676389") }, `{"image":449676389,"type":"rebuild"}`},
+		{func() (Action, error) { return s.client.Restore("25", "12389723") }, `{"image":12389723,"type":"restore"}`},
+		{func() (Action, error) { return s.client.Snapshot("25", "Nifty New Snapshot") }, `{"name":"Nifty New Snapshot","type":"snapshot"}`},
+		{func() (Action, error) { return s.client.ChangeKernel("25", "991") }, `{"kernel":991,"type":"change_kernel"}`},
+		{func() (Action, error) { return s.client.EnableBackups("25") }, `{"type":"enable_backups"}`},
+		{func() (Action, error) { return s.client.DisableBackups("25") }, `{"type":"disable_backups"}`},
+	}
+
+	for _, tc := range cases {
+		testServer.Response(201, nil, dropletExampleAction)
+
+		action, err := tc.action()
+
+		req := testServer.WaitRequest()
+
+		c.Assert(err, IsNil)
+		c.Assert(action.StringId(), Equals, "15")
+		c.Assert(req.Method, Equals, "POST")
+		c.Assert(req.URL.Path, Equals, "/droplets/25/actions")
+
+		body, _ := ioutil.ReadAll(req.Body)
+		c.Assert(strings.TrimSpace(string(body)), Equals, tc.body)
+	}
+}
+
+var dropletImageJSON = `{
+  "id": 7938206,
+  "name": "nginx-base-2014-12-02",
+  "distribution": "Ubuntu",
+  "slug": null,
+  "public": false,
+  "regions": ["nyc3"],
+  "created_at": "2014-11-14T16:44:03Z",
+  "type": "snapshot",
+  "min_disk_size": 20
+}`
+
 var dropletExampleActionError = `{
   "id": "unprocessable_entity",
   "message": "You specified an invalid size for Droplet creation."
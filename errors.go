@@ -0,0 +1,60 @@
+package digitalocean
+
+import (
+	"errors"
+	"fmt"
+)
+
+// APIError is returned for any request the API answered with a
+// non-2xx status. It is wrapped by the errors of every Client method,
+// so use errors.As or the Is* helpers to get at it.
+type APIError struct {
+	// StatusCode and Status are those of the HTTP response
+	StatusCode int
+	Status     string
+
+	// Id and Message are taken from the DoError body, if any
+	Id      string
+	Message string
+
+	// RequestId identifies the request to DigitalOcean support
+	RequestId string
+
+	// Method and Endpoint describe the failed request
+	Method   string
+	Endpoint string
+}
+
+func (e *APIError) Error() string {
+	if e.Id == "" && e.Message == "" {
+		return fmt.Sprintf("API Error: %s", e.Status)
+	}
+
+	return fmt.Sprintf("API Error: %s: %s", e.Id, e.Message)
+}
+
+// hasStatus returns whether err wraps an APIError with the status
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == status
+	}
+
+	return false
+}
+
+// IsNotFound returns whether the error is a 404 from the API
+func IsNotFound(err error) bool {
+	return hasStatus(err, 404)
+}
+
+// IsUnauthorized returns whether the error is a 401 from the API,
+// usually caused by a bad or revoked token
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, 401)
+}
+
+// IsRateLimited returns whether the error is a 429 from the API
+func IsRateLimited(err error) bool {
+	return hasStatus(err, 429)
+}
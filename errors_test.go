@@ -0,0 +1,74 @@
+package digitalocean
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestErrors(t *testing.T) {
+	TestingT(t)
+}
+
+func (s *S) Test_APIError_notFound(c *C) {
+	headers := map[string]string{"X-Request-Id": "abc-123"}
+	testServer.Response(404, headers, notFoundErrorExample)
+
+	_, err := s.client.RetrieveDroplet("25")
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, ErrorMatches, "Error retrieving droplet: API Error: not_found: The resource you were accessing could not be found.")
+	c.Assert(IsNotFound(err), Equals, true)
+	c.Assert(IsUnauthorized(err), Equals, false)
+
+	var apiErr *APIError
+	c.Assert(errors.As(err, &apiErr), Equals, true)
+	c.Assert(apiErr.StatusCode, Equals, 404)
+	c.Assert(apiErr.Id, Equals, "not_found")
+	c.Assert(apiErr.RequestId, Equals, "abc-123")
+	c.Assert(apiErr.Method, Equals, "GET")
+	c.Assert(apiErr.Endpoint, Equals, "/droplets/25")
+}
+
+func (s *S) Test_APIError_unauthorized(c *C) {
+	testServer.Response(401, nil, unauthorizedErrorExample)
+
+	err := s.client.VerifyAuthentication()
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(IsUnauthorized(err), Equals, true)
+}
+
+func (s *S) Test_APIError_noBody(c *C) {
+	testServer.Response(429, nil, "")
+
+	err := s.client.DestroyDroplet("25")
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, ErrorMatches, "Error destroying droplet: API Error: 429 Too Many Requests")
+	c.Assert(IsRateLimited(err), Equals, true)
+}
+
+func TestIsNotFound_otherErrors(t *testing.T) {
+	if IsNotFound(nil) {
+		t.Fatalf("nil is not a 404")
+	}
+
+	if IsNotFound(errors.New("API Error: 404 Not Found")) {
+		t.Fatalf("plain errors are not a 404")
+	}
+}
+
+var notFoundErrorExample = `{
+  "id": "not_found",
+  "message": "The resource you were accessing could not be found."
+}`
+
+var unauthorizedErrorExample = `{
+  "id": "unauthorized",
+  "message": "Unable to authenticate you."
+}`
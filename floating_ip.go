@@ -0,0 +1,238 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+)
+
+type FloatingIPResponse struct {
+	FloatingIP FloatingIP `json:"floating_ip"`
+}
+
+// FloatingIP is used to represent a retrieved floating IP. Droplet
+// is nil when the IP isn't assigned.
+type FloatingIP struct {
+	IP      string   `json:"ip"`
+	Region  Region   `json:"region"`
+	Droplet *Droplet `json:"droplet"`
+	Locked  bool     `json:"locked"`
+}
+
+// Returns the ID of the droplet the IP is assigned to, if any
+func (f *FloatingIP) DropletId() string {
+	if f.Droplet == nil {
+		return ""
+	}
+
+	return f.Droplet.StringId()
+}
+
+// CreateFloatingIP contains the request parameters to create a new
+// floating IP. Set either Region to reserve the IP in a region, or
+// DropletId to assign it to a droplet straight away.
+type CreateFloatingIP struct {
+	Region    string
+	DropletId string
+}
+
+// CreateFloatingIP creates a floating IP from the parameters specified
+// and returns an error if it fails. If no error and the IP is returned,
+// the FloatingIP was succesfully created.
+func (c *Client) CreateFloatingIP(opts *CreateFloatingIP) (string, error) {
+	return c.CreateFloatingIPContext(context.Background(), opts)
+}
+
+// CreateFloatingIPContext is the context-aware form of CreateFloatingIP.
+func (c *Client) CreateFloatingIPContext(ctx context.Context, opts *CreateFloatingIP) (string, error) {
+	// Make the request parameters
+	params := make(map[string]interface{})
+
+	if opts.Region != "" {
+		params["region"] = opts.Region
+	}
+
+	if opts.DropletId != "" {
+		params["droplet_id"] = idParam(opts.DropletId)
+	}
+
+	req, err := c.NewRequestContext(ctx, params, "POST", "/floating_ips")
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := checkResp(c.do(req))
+
+	if err != nil {
+		return "", fmt.Errorf("Error creating floating IP: %w", err)
+	}
+
+	ip := new(FloatingIPResponse)
+
+	err = decodeBody(resp, &ip)
+
+	if err != nil {
+		return "", fmt.Errorf("Error parsing floating IP response: %w", err)
+	}
+
+	// The request was successful
+	return ip.FloatingIP.IP, nil
+}
+
+// RetrieveFloatingIPs gets the list of all floating IPs, following
+// every page, and an error. An error will be returned for failed
+// requests with a nil slice.
+func (c *Client) RetrieveFloatingIPs() ([]FloatingIP, error) {
+	return c.RetrieveFloatingIPsContext(context.Background())
+}
+
+// RetrieveFloatingIPsContext is the context-aware form of RetrieveFloatingIPs.
+func (c *Client) RetrieveFloatingIPsContext(ctx context.Context) ([]FloatingIP, error) {
+	ips, err := collect(c.IterateFloatingIPsContext(ctx))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving floating IPs: %w", err)
+	}
+
+	// The request was successful
+	return ips, nil
+}
+
+// IterateFloatingIPs returns an Iterator over all floating IPs.
+func (c *Client) IterateFloatingIPs() *Iterator[FloatingIP] {
+	return c.IterateFloatingIPsContext(context.Background())
+}
+
+// IterateFloatingIPsContext is the context-aware form of IterateFloatingIPs.
+func (c *Client) IterateFloatingIPsContext(ctx context.Context) *Iterator[FloatingIP] {
+	return newIterator[FloatingIP](ctx, c, "/floating_ips", "floating_ips")
+}
+
+// RetrieveFloatingIP gets a floating IP by the address specified and
+// returns a FloatingIP and an error. An error will be returned for
+// failed requests with a nil FloatingIP.
+func (c *Client) RetrieveFloatingIP(ip string) (FloatingIP, error) {
+	return c.RetrieveFloatingIPContext(context.Background(), ip)
+}
+
+// RetrieveFloatingIPContext is the context-aware form of RetrieveFloatingIP.
+func (c *Client) RetrieveFloatingIPContext(ctx context.Context, ip string) (FloatingIP, error) {
+	req, err := c.NewRequestContext(ctx, nil, "GET", fmt.Sprintf("/floating_ips/%s", ip))
+
+	if err != nil {
+		return FloatingIP{}, err
+	}
+
+	resp, err := checkResp(c.do(req))
+	if err != nil {
+		return FloatingIP{}, fmt.Errorf("Error retrieving floating IP: %w", err)
+	}
+
+	floatingIP := new(FloatingIPResponse)
+
+	err = decodeBody(resp, floatingIP)
+
+	if err != nil {
+		return FloatingIP{}, fmt.Errorf("Error decoding floating IP response: %w", err)
+	}
+
+	// The request was successful
+	return floatingIP.FloatingIP, nil
+}
+
+// DestroyFloatingIP releases a floating IP by the address specified
+// and returns an error if it fails. If no error is returned, the
+// FloatingIP was succesfully destroyed.
+func (c *Client) DestroyFloatingIP(ip string) error {
+	return c.DestroyFloatingIPContext(context.Background(), ip)
+}
+
+// DestroyFloatingIPContext is the context-aware form of DestroyFloatingIP.
+func (c *Client) DestroyFloatingIPContext(ctx context.Context, ip string) error {
+	req, err := c.NewRequestContext(ctx, nil, "DELETE", fmt.Sprintf("/floating_ips/%s", ip))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = checkResp(c.do(req))
+
+	if err != nil {
+		return fmt.Errorf("Error destroying floating IP: %w", err)
+	}
+
+	// The request was successful
+	return nil
+}
+
+// FloatingIPAction sends the specified action to the floating IP and
+// returns the resulting Action. An error is retunred, and is nil if
+// successful
+func (c *Client) FloatingIPAction(ip string, action map[string]interface{}) (Action, error) {
+	return c.FloatingIPActionContext(context.Background(), ip, action)
+}
+
+// FloatingIPActionContext is the context-aware form of FloatingIPAction.
+func (c *Client) FloatingIPActionContext(ctx context.Context, ip string, action map[string]interface{}) (Action, error) {
+	req, err := c.NewRequestContext(ctx, action, "POST", fmt.Sprintf("/floating_ips/%s/actions", ip))
+
+	if err != nil {
+		return Action{}, err
+	}
+
+	resp, err := checkResp(c.do(req))
+	if err != nil {
+		return Action{}, fmt.Errorf("Error processing floating IP action: %w", err)
+	}
+
+	return decodeAction(resp)
+}
+
+// Assigns the floating IP to the droplet ID specified, taking it
+// from any droplet it was assigned to
+func (c *Client) AssignFloatingIP(ip string, dropletId string) (Action, error) {
+	return c.AssignFloatingIPContext(context.Background(), ip, dropletId)
+}
+
+// AssignFloatingIPContext is the context-aware form of AssignFloatingIP.
+func (c *Client) AssignFloatingIPContext(ctx context.Context, ip string, dropletId string) (Action, error) {
+	return c.FloatingIPActionContext(ctx, ip, map[string]interface{}{
+		"type":       "assign",
+		"droplet_id": idParam(dropletId),
+	})
+}
+
+// Unassigns the floating IP from its droplet
+func (c *Client) UnassignFloatingIP(ip string) (Action, error) {
+	return c.UnassignFloatingIPContext(context.Background(), ip)
+}
+
+// UnassignFloatingIPContext is the context-aware form of UnassignFloatingIP.
+func (c *Client) UnassignFloatingIPContext(ctx context.Context, ip string) (Action, error) {
+	return c.FloatingIPActionContext(ctx, ip, map[string]interface{}{
+		"type": "unassign",
+	})
+}
+
+// RetrieveDropletFloatingIP returns the floating IP currently assigned
+// to the droplet by the ID specified, or an empty string if there is
+// none.
+func (c *Client) RetrieveDropletFloatingIP(id string) (string, error) {
+	return c.RetrieveDropletFloatingIPContext(context.Background(), id)
+}
+
+// RetrieveDropletFloatingIPContext is the context-aware form of RetrieveDropletFloatingIP.
+func (c *Client) RetrieveDropletFloatingIPContext(ctx context.Context, id string) (string, error) {
+	ips, err := c.RetrieveFloatingIPsContext(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, ip := range ips {
+		if ip.DropletId() == id {
+			return ip.IP, nil
+		}
+	}
+
+	return "", nil
+}
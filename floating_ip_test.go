@@ -0,0 +1,166 @@
+package digitalocean
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestFloatingIP(t *testing.T) {
+	TestingT(t)
+}
+
+func (s *S) Test_CreateFloatingIP(c *C) {
+	testServer.Response(202, nil, floatingIPExample)
+
+	ip, err := s.client.CreateFloatingIP(&CreateFloatingIP{DropletId: "25"})
+
+	req := testServer.WaitRequest()
+
+	body, _ := ioutil.ReadAll(req.Body)
+
+	c.Assert(err, IsNil)
+	c.Assert(ip, Equals, "45.55.96.47")
+	c.Assert(strings.TrimSpace(string(body)), Equals, `{"droplet_id":25}`)
+}
+
+func (s *S) Test_CreateFloatingIP_region(c *C) {
+	testServer.Response(202, nil, floatingIPExample)
+
+	_, err := s.client.CreateFloatingIP(&CreateFloatingIP{Region: "nyc3"})
+
+	req := testServer.WaitRequest()
+
+	body, _ := ioutil.ReadAll(req.Body)
+
+	c.Assert(err, IsNil)
+	c.Assert(strings.TrimSpace(string(body)), Equals, `{"region":"nyc3"}`)
+}
+
+func (s *S) Test_RetrieveFloatingIP(c *C) {
+	testServer.Response(200, nil, floatingIPExample)
+
+	ip, err := s.client.RetrieveFloatingIP("45.55.96.47")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/floating_ips/45.55.96.47")
+	c.Assert(ip.Region.Slug, Equals, "nyc3")
+	c.Assert(ip.DropletId(), Equals, "25")
+	c.Assert(ip.Locked, Equals, false)
+}
+
+func (s *S) Test_RetrieveFloatingIPs(c *C) {
+	testServer.Response(200, nil, floatingIPsExample)
+
+	ips, err := s.client.RetrieveFloatingIPs()
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(ips, HasLen, 2)
+	c.Assert(ips[0].DropletId(), Equals, "")
+	c.Assert(ips[1].DropletId(), Equals, "25")
+}
+
+func (s *S) Test_DestroyFloatingIP(c *C) {
+	testServer.Response(204, nil, "")
+
+	err := s.client.DestroyFloatingIP("45.55.96.47")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.Method, Equals, "DELETE")
+}
+
+func (s *S) Test_AssignFloatingIP(c *C) {
+	testServer.Response(201, nil, dropletExampleAction)
+
+	action, err := s.client.AssignFloatingIP("45.55.96.47", "25")
+
+	req := testServer.WaitRequest()
+
+	body, _ := ioutil.ReadAll(req.Body)
+
+	c.Assert(err, IsNil)
+	c.Assert(action.StringId(), Equals, "15")
+	c.Assert(req.URL.Path, Equals, "/floating_ips/45.55.96.47/actions")
+	c.Assert(strings.TrimSpace(string(body)), Equals, `{"droplet_id":25,"type":"assign"}`)
+}
+
+func (s *S) Test_UnassignFloatingIP(c *C) {
+	testServer.Response(201, nil, dropletExampleAction)
+
+	_, err := s.client.UnassignFloatingIP("45.55.96.47")
+
+	req := testServer.WaitRequest()
+
+	body, _ := ioutil.ReadAll(req.Body)
+
+	c.Assert(err, IsNil)
+	c.Assert(strings.TrimSpace(string(body)), Equals, `{"type":"unassign"}`)
+}
+
+func (s *S) Test_RetrieveDropletFloatingIP(c *C) {
+	testServer.Responses(2, 200, nil, floatingIPsExample)
+
+	ip, err := s.client.RetrieveDropletFloatingIP("25")
+	c.Assert(err, IsNil)
+	c.Assert(ip, Equals, "45.55.96.48")
+
+	ip, err = s.client.RetrieveDropletFloatingIP("26")
+	c.Assert(err, IsNil)
+	c.Assert(ip, Equals, "")
+
+	_ = testServer.WaitRequests(2)
+}
+
+var floatingIPExample = `{
+  "floating_ip": {
+    "ip": "45.55.96.47",
+    "droplet": {
+      "id": 25,
+      "name": "My-Droplet"
+    },
+    "region": {
+      "name": "New York 3",
+      "slug": "nyc3",
+      "available": true
+    },
+    "locked": false
+  }
+}`
+
+var floatingIPsExample = `{
+  "floating_ips": [
+    {
+      "ip": "45.55.96.47",
+      "droplet": null,
+      "region": {
+        "name": "New York 3",
+        "slug": "nyc3"
+      },
+      "locked": false
+    },
+    {
+      "ip": "45.55.96.48",
+      "droplet": {
+        "id": 25,
+        "name": "My-Droplet"
+      },
+      "region": {
+        "name": "New York 3",
+        "slug": "nyc3"
+      },
+      "locked": false
+    }
+  ],
+  "links": {},
+  "meta": {
+    "total": 2
+  }
+}`
@@ -0,0 +1,218 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type ImageResponse struct {
+	Image Image `json:"image"`
+}
+
+// Image is used to represent a DigitalOcean image: a distribution,
+// a one-click application, a snapshot or a backup.
+type Image struct {
+	Id           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Type         string    `json:"type"`
+	Distribution string    `json:"distribution"`
+	Slug         string    `json:"slug"`
+	Public       bool      `json:"public"`
+	Regions      []string  `json:"regions"`
+	MinDiskSize  int       `json:"min_disk_size"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Returns the ID of the image as a string
+func (i *Image) StringId() string {
+	return strconv.FormatInt(i.Id, 10)
+}
+
+// ListImages contains the parameters to filter the list of images.
+// The zero value lists every image.
+type ListImages struct {
+	Type    string // "distribution" or "application" to only list those
+	Private bool   // true to only list the account's own images
+}
+
+// endpoint returns the list endpoint matching the filters
+func (opts *ListImages) endpoint() string {
+	endpoint := "/images"
+
+	if opts == nil {
+		return endpoint
+	}
+
+	if opts.Type != "" {
+		endpoint = addQuery(endpoint, "type", opts.Type)
+	}
+
+	if opts.Private {
+		endpoint = addQuery(endpoint, "private", "true")
+	}
+
+	return endpoint
+}
+
+// RetrieveImages gets the list of images matching the filters, following
+// every page, and an error. An error will be returned for failed requests
+// with a nil slice.
+func (c *Client) RetrieveImages(opts *ListImages) ([]Image, error) {
+	return c.RetrieveImagesContext(context.Background(), opts)
+}
+
+// RetrieveImagesContext is the context-aware form of RetrieveImages.
+func (c *Client) RetrieveImagesContext(ctx context.Context, opts *ListImages) ([]Image, error) {
+	images, err := collect(c.IterateImagesContext(ctx, opts))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving images: %w", err)
+	}
+
+	// The request was successful
+	return images, nil
+}
+
+// IterateImages returns an Iterator over the images matching
+// the filters.
+func (c *Client) IterateImages(opts *ListImages) *Iterator[Image] {
+	return c.IterateImagesContext(context.Background(), opts)
+}
+
+// IterateImagesContext is the context-aware form of IterateImages.
+func (c *Client) IterateImagesContext(ctx context.Context, opts *ListImages) *Iterator[Image] {
+	return newIterator[Image](ctx, c, opts.endpoint(), "images")
+}
+
+// RetrieveImage gets an image by the ID or slug specified and
+// returns an Image and an error. An error will be returned for
+// failed requests with a nil Image.
+func (c *Client) RetrieveImage(id string) (Image, error) {
+	return c.RetrieveImageContext(context.Background(), id)
+}
+
+// RetrieveImageContext is the context-aware form of RetrieveImage.
+func (c *Client) RetrieveImageContext(ctx context.Context, id string) (Image, error) {
+	req, err := c.NewRequestContext(ctx, nil, "GET", fmt.Sprintf("/images/%s", id))
+
+	if err != nil {
+		return Image{}, err
+	}
+
+	resp, err := checkResp(c.do(req))
+	if err != nil {
+		return Image{}, fmt.Errorf("Error retrieving image: %w", err)
+	}
+
+	image := new(ImageResponse)
+
+	err = decodeBody(resp, image)
+
+	if err != nil {
+		return Image{}, fmt.Errorf("Error decoding image response: %w", err)
+	}
+
+	// The request was successful
+	return image.Image, nil
+}
+
+// RenameImage renames an image to the name specified
+func (c *Client) RenameImage(id string, name string) error {
+	return c.RenameImageContext(context.Background(), id, name)
+}
+
+// RenameImageContext is the context-aware form of RenameImage.
+func (c *Client) RenameImageContext(ctx context.Context, id string, name string) error {
+	params := map[string]string{
+		"name": name,
+	}
+
+	req, err := c.NewRequestContext(ctx, params, "PUT", fmt.Sprintf("/images/%s", id))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = checkResp(c.do(req))
+
+	if err != nil {
+		return fmt.Errorf("Error updating image: %w", err)
+	}
+
+	// The request was successful
+	return nil
+}
+
+// DestroyImage destroys an image by the ID specified and
+// returns an error if it fails. If no error is returned,
+// the Image was succesfully destroyed.
+func (c *Client) DestroyImage(id string) error {
+	return c.DestroyImageContext(context.Background(), id)
+}
+
+// DestroyImageContext is the context-aware form of DestroyImage.
+func (c *Client) DestroyImageContext(ctx context.Context, id string) error {
+	req, err := c.NewRequestContext(ctx, nil, "DELETE", fmt.Sprintf("/images/%s", id))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = checkResp(c.do(req))
+
+	if err != nil {
+		return fmt.Errorf("Error destroying image: %w", err)
+	}
+
+	// The request was successful
+	return nil
+}
+
+// ImageAction sends the specified action to the image and returns
+// the resulting Action. An error is retunred, and is nil if successful
+func (c *Client) ImageAction(id string, action map[string]interface{}) (Action, error) {
+	return c.ImageActionContext(context.Background(), id, action)
+}
+
+// ImageActionContext is the context-aware form of ImageAction.
+func (c *Client) ImageActionContext(ctx context.Context, id string, action map[string]interface{}) (Action, error) {
+	req, err := c.NewRequestContext(ctx, action, "POST", fmt.Sprintf("/images/%s/actions", id))
+
+	if err != nil {
+		return Action{}, err
+	}
+
+	resp, err := checkResp(c.do(req))
+	if err != nil {
+		return Action{}, fmt.Errorf("Error processing image action: %w", err)
+	}
+
+	return decodeAction(resp)
+}
+
+// Transfers an image to the region slug specified
+func (c *Client) TransferImage(id string, region string) (Action, error) {
+	return c.TransferImageContext(context.Background(), id, region)
+}
+
+// TransferImageContext is the context-aware form of TransferImage.
+func (c *Client) TransferImageContext(ctx context.Context, id string, region string) (Action, error) {
+	return c.ImageActionContext(ctx, id, map[string]interface{}{
+		"type":   "transfer",
+		"region": region,
+	})
+}
+
+// Converts a backup image into a snapshot
+func (c *Client) ConvertImage(id string) (Action, error) {
+	return c.ConvertImageContext(context.Background(), id)
+}
+
+// ConvertImageContext is the context-aware form of ConvertImage.
+func (c *Client) ConvertImageContext(ctx context.Context, id string) (Action, error) {
+	return c.ImageActionContext(ctx, id, map[string]interface{}{
+		"type": "convert",
+	})
+}
@@ -0,0 +1,168 @@
+package digitalocean
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestImage(t *testing.T) {
+	TestingT(t)
+}
+
+func (s *S) Test_RetrieveImages(c *C) {
+	testServer.Response(200, nil, imagesExample)
+
+	images, err := s.client.RetrieveImages(&ListImages{Type: "distribution"})
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/images")
+	c.Assert(req.URL.Query().Get("type"), Equals, "distribution")
+	c.Assert(req.URL.Query().Get("private"), Equals, "")
+	c.Assert(images, HasLen, 2)
+	c.Assert(images[0].Slug, Equals, "ubuntu-14-04-x64")
+	c.Assert(images[0].MinDiskSize, Equals, 20)
+	c.Assert(images[1].Distribution, Equals, "CentOS")
+}
+
+func (s *S) Test_RetrieveImages_private(c *C) {
+	testServer.Response(200, nil, imagesExample)
+
+	_, err := s.client.RetrieveImages(&ListImages{Private: true})
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Query().Get("private"), Equals, "true")
+	c.Assert(req.URL.Query().Get("type"), Equals, "")
+}
+
+func (s *S) Test_RetrieveImages_all(c *C) {
+	testServer.Response(200, nil, imagesExample)
+
+	_, err := s.client.RetrieveImages(nil)
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.RawQuery, Equals, "per_page=200")
+}
+
+func (s *S) Test_RetrieveImage(c *C) {
+	testServer.Response(200, nil, imageExample)
+
+	image, err := s.client.RetrieveImage("ubuntu-14-04-x64")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/images/ubuntu-14-04-x64")
+	c.Assert(image.StringId(), Equals, "6918990")
+	c.Assert(image.Regions, DeepEquals, []string{"nyc1", "ams1"})
+	c.Assert(image.CreatedAt.Year(), Equals, 2014)
+}
+
+func (s *S) Test_RenameImage(c *C) {
+	testServer.Response(200, nil, imageExample)
+
+	err := s.client.RenameImage("6918990", "new-name")
+
+	req := testServer.WaitRequest()
+
+	body, _ := ioutil.ReadAll(req.Body)
+
+	c.Assert(err, IsNil)
+	c.Assert(req.Method, Equals, "PUT")
+	c.Assert(strings.TrimSpace(string(body)), Equals, `{"name":"new-name"}`)
+}
+
+func (s *S) Test_DestroyImage(c *C) {
+	testServer.Response(204, nil, "")
+
+	err := s.client.DestroyImage("6918990")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.Method, Equals, "DELETE")
+}
+
+func (s *S) Test_TransferImage(c *C) {
+	testServer.Response(201, nil, dropletExampleAction)
+
+	action, err := s.client.TransferImage("6918990", "nyc2")
+
+	req := testServer.WaitRequest()
+
+	body, _ := ioutil.ReadAll(req.Body)
+
+	c.Assert(err, IsNil)
+	c.Assert(action.StringId(), Equals, "15")
+	c.Assert(req.URL.Path, Equals, "/images/6918990/actions")
+	c.Assert(strings.TrimSpace(string(body)), Equals, `{"region":"nyc2","type":"transfer"}`)
+}
+
+func (s *S) Test_ConvertImage(c *C) {
+	testServer.Response(201, nil, dropletExampleAction)
+
+	_, err := s.client.ConvertImage("6918990")
+
+	req := testServer.WaitRequest()
+
+	body, _ := ioutil.ReadAll(req.Body)
+
+	c.Assert(err, IsNil)
+	c.Assert(strings.TrimSpace(string(body)), Equals, `{"type":"convert"}`)
+}
+
+var imageExample = `{
+  "image": {
+    "id": 6918990,
+    "name": "14.04 x64",
+    "distribution": "Ubuntu",
+    "slug": "ubuntu-14-04-x64",
+    "public": true,
+    "regions": [
+      "nyc1",
+      "ams1"
+    ],
+    "created_at": "2014-10-17T20:24:33Z",
+    "type": "snapshot",
+    "min_disk_size": 30
+  }
+}`
+
+var imagesExample = `{
+  "images": [
+    {
+      "id": 6918990,
+      "name": "14.04 x64",
+      "distribution": "Ubuntu",
+      "slug": "ubuntu-14-04-x64",
+      "public": true,
+      "regions": ["nyc1", "ams1"],
+      "created_at": "2014-10-17T20:24:33Z",
+      "type": "snapshot",
+      "min_disk_size": 20
+    },
+    {
+      "id": 6372321,
+      "name": "5.10 x64",
+      "distribution": "CentOS",
+      "slug": "centos-5-8-x64",
+      "public": true,
+      "regions": ["nyc1"],
+      "created_at": "2014-09-26T16:40:18Z",
+      "type": "snapshot",
+      "min_disk_size": 20
+    }
+  ],
+  "links": {},
+  "meta": {
+    "total": 2
+  }
+}`
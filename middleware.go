@@ -0,0 +1,73 @@
+package digitalocean
+
+import (
+	"log"
+	"net/http"
+	"time"
+)
+
+// RoundTripFunc sends a request and returns its response, like
+// http.Client.Do
+type RoundTripFunc func(*http.Request) (*http.Response, error)
+
+// Middleware wraps the sending of requests. It can change the request
+// before calling next, inspect or replace the response, or answer
+// without calling next at all. Requests should be cloned before they
+// are changed.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use appends middleware to the chain run around every request
+func (c *Client) Use(m ...Middleware) {
+	c.Middleware = append(c.Middleware, m...)
+}
+
+// roundTrip returns the HTTP client wrapped in the middleware chain
+func (c *Client) roundTrip() RoundTripFunc {
+	rt := RoundTripFunc(c.Http.Do)
+
+	for i := len(c.Middleware) - 1; i >= 0; i-- {
+		rt = c.Middleware[i](rt)
+	}
+
+	return rt
+}
+
+// UserAgent returns a middleware that sets the User-Agent header of
+// every request to the value specified
+func UserAgent(userAgent string) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			req.Header.Set("User-Agent", userAgent)
+
+			return next(req)
+		}
+	}
+}
+
+// Logging returns a middleware that logs the method, URL, status and
+// duration of every request to the logger specified, or to the
+// standard logger if it is nil
+func Logging(logger *log.Logger) Middleware {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+
+			resp, err := next(req)
+
+			elapsed := time.Since(start)
+
+			if err != nil {
+				logger.Printf("digitalocean: %s %s: %s (%s)", req.Method, req.URL.Path, err, elapsed)
+			} else {
+				logger.Printf("digitalocean: %s %s: %s (%s)", req.Method, req.URL.Path, resp.Status, elapsed)
+			}
+
+			return resp, err
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package digitalocean
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestMiddleware(t *testing.T) {
+	TestingT(t)
+}
+
+func middlewareClient(c *C, m ...Middleware) *Client {
+	client, err := NewClient("foobar")
+	c.Assert(err, IsNil)
+
+	client.URL = "http://localhost:4444"
+	client.Use(m...)
+
+	return client
+}
+
+// tagMiddleware records its name when called and adds it to the
+// X-Tags header of the request
+func tagMiddleware(name string, calls *[]string) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			*calls = append(*calls, name)
+
+			req = req.Clone(req.Context())
+			req.Header.Add("X-Tags", name)
+
+			return next(req)
+		}
+	}
+}
+
+func (s *S) Test_Middleware_Order(c *C) {
+	testServer.Response(200, nil, dropletExample)
+
+	var calls []string
+	client := middlewareClient(c, tagMiddleware("outer", &calls), tagMiddleware("inner", &calls))
+
+	_, err := client.RetrieveDroplet("25")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(calls, DeepEquals, []string{"outer", "inner"})
+	c.Assert(req.Header["X-Tags"], DeepEquals, []string{"outer", "inner"})
+}
+
+func (s *S) Test_Middleware_EveryAttempt(c *C) {
+	testServer.Response(503, nil, "")
+	testServer.Response(200, nil, dropletExample)
+
+	var calls []string
+	client := retryClient(c)
+	client.Use(tagMiddleware("tag", &calls))
+
+	_, err := client.RetrieveDroplet("25")
+
+	_ = testServer.WaitRequests(2)
+
+	c.Assert(err, IsNil)
+	c.Assert(calls, HasLen, 2)
+}
+
+func (s *S) Test_Middleware_ShortCircuit(c *C) {
+	fault := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 503,
+				Status:     "503 Service Unavailable",
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"id": "service_unavailable", "message": "injected"}`)),
+				Request:    req,
+			}, nil
+		}
+	}
+
+	_, err := middlewareClient(c, fault).RetrieveDroplet("25")
+
+	c.Assert(err, ErrorMatches, ".*API Error: service_unavailable: injected")
+	var apiErr *APIError
+	c.Assert(errors.As(err, &apiErr), Equals, true)
+	c.Assert(apiErr.StatusCode, Equals, 503)
+	c.Assert(apiErr.Endpoint, Equals, "/droplets/25")
+}
+
+func (s *S) Test_UserAgent(c *C) {
+	testServer.Response(200, nil, dropletExample)
+
+	_, err := middlewareClient(c, UserAgent("deployer/1.0")).RetrieveDroplet("25")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.Header.Get("User-Agent"), Equals, "deployer/1.0")
+}
+
+func (s *S) Test_Logging(c *C) {
+	testServer.Response(404, nil, "")
+
+	var buf bytes.Buffer
+	logger := log.New(&buf, "", 0)
+
+	_, err := middlewareClient(c, Logging(logger)).RetrieveDroplet("25")
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, NotNil)
+	c.Assert(buf.String(), Matches, `digitalocean: GET /droplets/25: 404 Not Found \(.*\)\n`)
+}
@@ -0,0 +1,203 @@
+package digitalocean
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// perPage is the page size requested from list endpoints, the
+// largest the API allows
+const perPage = 200
+
+// Links holds the links returned with a page of a list response
+type Links struct {
+	Pages Pages `json:"pages"`
+}
+
+// Pages holds the URLs of the pages around the current one. They
+// are empty when there is no such page.
+type Pages struct {
+	First string `json:"first"`
+	Prev  string `json:"prev"`
+	Next  string `json:"next"`
+	Last  string `json:"last"`
+}
+
+// Meta holds the metadata returned with a page of a list response
+type Meta struct {
+	Total int `json:"total"`
+}
+
+// Iterator lazily walks a paginated list endpoint, fetching a page
+// only once the previous one has been consumed. Use it like a
+// bufio.Scanner:
+//
+//	it := client.IterateDroplets()
+//	for it.Next() {
+//		droplet := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	client *Client
+	ctx    context.Context
+
+	// key is the JSON key the items are listed under
+	key string
+
+	// next is the endpoint of the next page, empty once the
+	// last page was fetched
+	next string
+
+	items   []T
+	current T
+	total   int
+	err     error
+}
+
+// newIterator returns an Iterator over the items listed under key
+// by the endpoint
+func newIterator[T any](ctx context.Context, c *Client, endpoint string, key string) *Iterator[T] {
+	return &Iterator[T]{
+		client: c,
+		ctx:    ctx,
+		key:    key,
+		next:   addQuery(endpoint, "per_page", fmt.Sprint(perPage)),
+	}
+}
+
+// Next advances to the next item, fetching a new page if needed. It
+// returns false when there are no more items or a request failed.
+func (it *Iterator[T]) Next() bool {
+	for len(it.items) == 0 {
+		if it.err != nil || it.next == "" {
+			return false
+		}
+
+		it.fetch()
+	}
+
+	it.current = it.items[0]
+	it.items = it.items[1:]
+
+	return true
+}
+
+// Value returns the current item
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Total returns the total number of items reported by the API. It
+// is only known once the first page has been fetched.
+func (it *Iterator[T]) Total() int {
+	return it.total
+}
+
+// fetch retrieves the next page
+func (it *Iterator[T]) fetch() {
+	req, err := it.client.NewRequestContext(it.ctx, nil, "GET", it.next)
+
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	resp, err := checkResp(it.client.do(req))
+	if err != nil {
+		it.err = err
+		return
+	}
+	defer resp.Body.Close()
+
+	page := make(map[string]json.RawMessage)
+
+	if err = decodeBody(resp, &page); err != nil {
+		it.err = fmt.Errorf("Error decoding %s response: %w", it.key, err)
+		return
+	}
+
+	var items []T
+	var links Links
+	var meta Meta
+
+	for key, out := range map[string]interface{}{it.key: &items, "links": &links, "meta": &meta} {
+		if raw, ok := page[key]; ok {
+			if err = json.Unmarshal(raw, out); err != nil {
+				it.err = fmt.Errorf("Error decoding %s response: %w", it.key, err)
+				return
+			}
+		}
+	}
+
+	it.items = items
+	it.total = meta.Total
+	it.next = ""
+
+	if links.Pages.Next != "" {
+		if it.next, err = it.client.relativeEndpoint(links.Pages.Next); err != nil {
+			it.err = err
+		}
+	}
+}
+
+// collect drains the iterator into a slice
+func collect[T any](it *Iterator[T]) ([]T, error) {
+	var all []T
+
+	for it.Next() {
+		if all == nil {
+			all = make([]T, 0, it.Total())
+		}
+		all = append(all, it.Value())
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	if all == nil {
+		all = []T{}
+	}
+
+	return all, nil
+}
+
+// relativeEndpoint turns a page link, which is a full URL, into an
+// endpoint relative to the client URL
+func (c *Client) relativeEndpoint(link string) (string, error) {
+	if strings.HasPrefix(link, c.URL) {
+		return strings.TrimPrefix(link, c.URL), nil
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing page link: %w", err)
+	}
+
+	base, err := url.Parse(c.URL)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing base URL: %w", err)
+	}
+
+	return strings.TrimPrefix(u.RequestURI(), strings.TrimSuffix(base.Path, "/")), nil
+}
+
+// addQuery adds a query parameter to an endpoint
+func addQuery(endpoint string, key string, value string) string {
+	sep := "?"
+	if strings.Contains(endpoint, "?") {
+		sep = "&"
+	}
+
+	return endpoint + sep + url.QueryEscape(key) + "=" + url.QueryEscape(value)
+}
@@ -0,0 +1,137 @@
+package digitalocean
+
+import (
+	"testing"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestPagination(t *testing.T) {
+	TestingT(t)
+}
+
+func (s *S) Test_RetrieveDroplets_pages(c *C) {
+	testServer.Response(200, nil, dropletsPageOneExample)
+	testServer.Response(200, nil, dropletsPageTwoExample)
+
+	droplets, err := s.client.RetrieveDroplets()
+
+	reqs := testServer.WaitRequests(2)
+
+	c.Assert(err, IsNil)
+	c.Assert(len(droplets), Equals, 3)
+	c.Assert(droplets[0].StringId(), Equals, "25")
+	c.Assert(droplets[2].StringId(), Equals, "27")
+
+	c.Assert(reqs[0].URL.Query().Get("per_page"), Equals, "200")
+	c.Assert(reqs[1].URL.Path, Equals, "/droplets")
+	c.Assert(reqs[1].URL.Query().Get("page"), Equals, "2")
+}
+
+func (s *S) Test_RetrieveDroplets_pageError(c *C) {
+	testServer.Response(200, nil, dropletsPageOneExample)
+	testServer.Response(500, nil, "")
+
+	droplets, err := s.client.RetrieveDroplets()
+
+	_ = testServer.WaitRequests(2)
+
+	c.Assert(droplets, IsNil)
+	c.Assert(err, ErrorMatches, "Error retrieving droplets: API Error: 500 Internal Server Error")
+}
+
+func (s *S) Test_IterateDroplets(c *C) {
+	testServer.Response(200, nil, dropletsPageOneExample)
+	testServer.Response(200, nil, dropletsPageTwoExample)
+
+	it := s.client.IterateDroplets()
+
+	c.Assert(it.Next(), Equals, true)
+	c.Assert(it.Value().Id, Equals, int64(25))
+	c.Assert(it.Total(), Equals, 3)
+
+	c.Assert(it.Next(), Equals, true)
+	c.Assert(it.Value().Id, Equals, int64(26))
+
+	// The second page is only fetched once the first is consumed
+	_ = testServer.WaitRequest()
+
+	c.Assert(it.Next(), Equals, true)
+	c.Assert(it.Value().Id, Equals, int64(27))
+	c.Assert(it.Next(), Equals, false)
+	c.Assert(it.Err(), IsNil)
+
+	_ = testServer.WaitRequest()
+}
+
+func (s *S) Test_IterateDroplets_empty(c *C) {
+	testServer.Response(200, nil, `{"droplets": [], "links": {}, "meta": {"total": 0}}`)
+
+	droplets, err := s.client.RetrieveDroplets()
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(droplets, HasLen, 0)
+}
+
+func TestClient_relativeEndpoint(t *testing.T) {
+	c := makeClient(t)
+
+	cases := map[string]string{
+		"https://api.digitalocean.com/v2/droplets?page=2": "/droplets?page=2",
+		"http://example.org/v2/images?page=3&type=backup": "/images?page=3&type=backup",
+	}
+
+	for link, expected := range cases {
+		endpoint, err := c.relativeEndpoint(link)
+		if err != nil {
+			t.Fatalf("err: %v", err)
+		}
+
+		if endpoint != expected {
+			t.Fatalf("bad endpoint for %s: %s", link, endpoint)
+		}
+	}
+}
+
+func TestAddQuery(t *testing.T) {
+	if e := addQuery("/images", "page", "2"); e != "/images?page=2" {
+		t.Fatalf("bad: %s", e)
+	}
+
+	if e := addQuery("/images?type=backup", "page", "2"); e != "/images?type=backup&page=2" {
+		t.Fatalf("bad: %s", e)
+	}
+}
+
+var dropletsPageOneExample = `{
+  "droplets": [
+    {"id": 25, "name": "one"},
+    {"id": 26, "name": "two"}
+  ],
+  "links": {
+    "pages": {
+      "last": "http://localhost:4444/droplets?page=2&per_page=2",
+      "next": "http://localhost:4444/droplets?page=2&per_page=2"
+    }
+  },
+  "meta": {
+    "total": 3
+  }
+}`
+
+var dropletsPageTwoExample = `{
+  "droplets": [
+    {"id": 27, "name": "three"}
+  ],
+  "links": {
+    "pages": {
+      "first": "http://localhost:4444/droplets?page=1&per_page=2",
+      "prev": "http://localhost:4444/droplets?page=1&per_page=2"
+    }
+  },
+  "meta": {
+    "total": 3
+  }
+}`
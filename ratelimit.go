@@ -0,0 +1,157 @@
+package digitalocean
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Rate is the rate limit state reported by the API in the
+// RateLimit-* headers of the last response
+type Rate struct {
+	// Limit is the number of requests allowed per hour
+	Limit int
+
+	// Remaining is the number of requests left in the window
+	Remaining int
+
+	// Reset is when the window resets and Remaining is refilled
+	Reset time.Time
+}
+
+// parseRate reads the rate limit headers from a response. The
+// boolean is false if the response didn't carry them.
+func parseRate(h http.Header) (Rate, bool) {
+	limit, err := strconv.Atoi(h.Get("RateLimit-Limit"))
+	if err != nil {
+		return Rate{}, false
+	}
+
+	remaining, err := strconv.Atoi(h.Get("RateLimit-Remaining"))
+	if err != nil {
+		return Rate{}, false
+	}
+
+	rate := Rate{
+		Limit:     limit,
+		Remaining: remaining,
+	}
+
+	if reset, err := strconv.ParseInt(h.Get("RateLimit-Reset"), 10, 64); err == nil {
+		rate.Reset = time.Unix(reset, 0)
+	}
+
+	return rate, true
+}
+
+// Rate returns the rate limit state seen on the most recent
+// response. It is the zero Rate until a response carried the headers.
+func (c *Client) Rate() Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+
+	return c.rate
+}
+
+// updateRate records the rate limit state of a response
+func (c *Client) updateRate(resp *http.Response) {
+	rate, ok := parseRate(resp.Header)
+	if !ok {
+		return
+	}
+
+	c.rateMu.Lock()
+	c.rate = rate
+	c.rateMu.Unlock()
+}
+
+// throttle blocks until the client may send another request. It
+// does nothing unless a Limiter is configured, in which case it also
+// waits out the window once the API reports no requests remaining.
+func (c *Client) throttle(ctx context.Context) error {
+	if c.Limiter == nil {
+		return nil
+	}
+
+	rate := c.Rate()
+	if rate.Limit > 0 && rate.Remaining <= 0 {
+		if wait := time.Until(rate.Reset); wait > 0 {
+			if err := sleep(ctx, wait); err != nil {
+				return err
+			}
+		}
+	}
+
+	return c.Limiter.Wait(ctx)
+}
+
+// RateLimiter is a token bucket used to throttle requests client
+// side, so the API's limit is never reached
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a RateLimiter that allows perSecond requests
+// per second on average, with bursts of up to burst requests.
+func NewRateLimiter(perSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait
+// before using it
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 || l.rate <= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// Wait blocks until a request may be sent, or the context is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	return sleep(ctx, l.reserve(time.Now()))
+}
+
+// sleep waits for d, returning early with an error if the
+// context is done first
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
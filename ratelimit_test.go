@@ -0,0 +1,83 @@
+package digitalocean
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestRateLimit(t *testing.T) {
+	TestingT(t)
+}
+
+func (s *S) Test_Rate(c *C) {
+	headers := map[string]string{
+		"RateLimit-Limit":     "1200",
+		"RateLimit-Remaining": "1193",
+		"RateLimit-Reset":     "1402425459",
+	}
+	testServer.Response(200, headers, dropletExample)
+
+	_, err := s.client.RetrieveDroplet("25")
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+
+	rate := s.client.Rate()
+	c.Assert(rate.Limit, Equals, 1200)
+	c.Assert(rate.Remaining, Equals, 1193)
+	c.Assert(rate.Reset.Unix(), Equals, int64(1402425459))
+}
+
+func (s *S) Test_Rate_limited(c *C) {
+	client, err := NewClient("foobar")
+	c.Assert(err, IsNil)
+
+	client.URL = "http://localhost:4444"
+	client.Limiter = NewRateLimiter(1000, 1)
+
+	testServer.Responses(2, 200, nil, dropletExample)
+
+	for i := 0; i < 2; i++ {
+		_, err = client.RetrieveDroplet("25")
+		c.Assert(err, IsNil)
+	}
+
+	_ = testServer.WaitRequests(2)
+}
+
+func TestParseRate_missing(t *testing.T) {
+	h := http.Header{}
+	h.Set("RateLimit-Limit", "1200")
+
+	if _, ok := parseRate(h); ok {
+		t.Fatalf("parsed a rate without RateLimit-Remaining")
+	}
+}
+
+func TestRateLimiter_reserve(t *testing.T) {
+	l := NewRateLimiter(2, 2)
+	now := time.Now()
+
+	if wait := l.reserve(now); wait != 0 {
+		t.Fatalf("bad: %v", wait)
+	}
+
+	if wait := l.reserve(now); wait != 0 {
+		t.Fatalf("bad: %v", wait)
+	}
+
+	// The bucket is empty, so the next token is half a second away
+	if wait := l.reserve(now); wait != 500*time.Millisecond {
+		t.Fatalf("bad: %v", wait)
+	}
+
+	// A second later two tokens have been added, one of them
+	// already spoken for
+	if wait := l.reserve(now.Add(time.Second)); wait != 0 {
+		t.Fatalf("bad: %v", wait)
+	}
+}
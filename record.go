@@ -1,8 +1,11 @@
 package digitalocean
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"strconv"
+	"strings"
 )
 
 type RecordResponse struct {
@@ -19,6 +22,9 @@ type Record struct {
 	Priority int    `json:"priority"`
 	Port     int    `json:"port"`
 	Weight   int    `json:"weight"`
+	TTL      int    `json:"ttl"`
+	Flags    int    `json:"flags"`
+	Tag      string `json:"tag"`
 }
 
 func (r *Record) StringId() string {
@@ -37,8 +43,16 @@ func (r *Record) StringWeight() string {
 	return strconv.Itoa(r.Weight)
 }
 
+func (r *Record) StringTTL() string {
+	return strconv.Itoa(r.TTL)
+}
+
+func (r *Record) StringFlags() string {
+	return strconv.Itoa(r.Flags)
+}
+
 // CreateRecord contains the request parameters to create a new
-// record.
+// record. Flags and Tag are only used by CAA records.
 type CreateRecord struct {
 	Type     string
 	Name     string
@@ -46,46 +60,222 @@ type CreateRecord struct {
 	Priority string
 	Port     string
 	Weight   string
+	TTL      string
+	Flags    string
+	Tag      string
 }
 
-// CreateRecord creates a record from the parameters specified and
-// returns an error if it fails. If no error and the name is returned,
-// the Record was succesfully created.
-func (c *Client) CreateRecord(domain string, opts *CreateRecord) (string, error) {
-	// Make the request parameters
-	params := make(map[string]string)
+// maxTXTLength is the longest TXT record data the API accepts
+const maxTXTLength = 512
+
+// Validate checks the parameters against the rules of the record type,
+// so a malformed record is caught before any request is made:
+//
+//	A      Data must be an IPv4 address
+//	AAAA   Data must be an IPv6 address
+//	CNAME  Data must be a fully qualified name ending with a dot, or @
+//	MX     Priority is required
+//	SRV    Priority, Port and Weight are required
+//	CAA    Flags and Tag are required
+//	TXT    Data is required and at most 512 characters, and every quoted
+//	       string is at most 255 characters
+func (opts *CreateRecord) Validate() error {
+	if opts.Type == "" {
+		return fmt.Errorf("Error validating record: type is required")
+	}
 
-	params["type"] = opts.Type
+	// Types are checked whatever their case
+	recordType := strings.ToUpper(opts.Type)
+
+	// Numeric fields are checked whatever the type
+	numbers := []struct {
+		name  string
+		value string
+		max   int
+	}{
+		{"priority", opts.Priority, 65535},
+		{"port", opts.Port, 65535},
+		{"weight", opts.Weight, 65535},
+		{"flags", opts.Flags, 255},
+		{"ttl", opts.TTL, 2147483647},
+	}
 
-	if opts.Name != "" {
-		params["name"] = opts.Name
+	for _, n := range numbers {
+		if n.value == "" {
+			continue
+		}
+
+		i, err := strconv.Atoi(n.value)
+		if err != nil || i < 0 || i > n.max {
+			return fmt.Errorf("Error validating record: %s must be a number between 0 and %d, got %q", n.name, n.max, n.value)
+		}
+	}
+
+	if opts.TTL != "" {
+		if ttl, _ := strconv.Atoi(opts.TTL); ttl < 30 {
+			return fmt.Errorf("Error validating record: ttl must be at least 30, got %s", opts.TTL)
+		}
 	}
 
-	if opts.Data != "" {
-		params["data"] = opts.Data
+	invalid := func(format string, args ...interface{}) error {
+		return fmt.Errorf("Error validating record: %s record %s", recordType, fmt.Sprintf(format, args...))
 	}
 
-	if opts.Priority != "" {
-		params["priority"] = opts.Priority
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(opts.Data); ip == nil || ip.To4() == nil || strings.Contains(opts.Data, ":") {
+			return invalid("data must be an IPv4 address, got %q", opts.Data)
+		}
+	case "AAAA":
+		if ip := net.ParseIP(opts.Data); ip == nil || !strings.Contains(opts.Data, ":") {
+			return invalid("data must be an IPv6 address, got %q", opts.Data)
+		}
+	case "CNAME":
+		if opts.Data != "@" && !strings.HasSuffix(opts.Data, ".") {
+			return invalid("data must be a fully qualified name ending with a dot, got %q", opts.Data)
+		}
+	case "MX":
+		if opts.Data == "" {
+			return invalid("data is required")
+		}
+		if opts.Priority == "" {
+			return invalid("priority is required")
+		}
+	case "SRV":
+		if opts.Data == "" {
+			return invalid("data is required")
+		}
+		if opts.Priority == "" || opts.Port == "" || opts.Weight == "" {
+			return invalid("priority, port and weight are required")
+		}
+	case "CAA":
+		if opts.Data == "" {
+			return invalid("data is required")
+		}
+		if opts.Flags == "" {
+			return invalid("flags is required")
+		}
+		switch opts.Tag {
+		case "issue", "issuewild", "iodef":
+		default:
+			return invalid("tag must be issue, issuewild or iodef, got %q", opts.Tag)
+		}
+	case "TXT":
+		if opts.Data == "" {
+			return invalid("data is required")
+		}
+		// Only explicitly quoted strings are limited to 255 characters,
+		// the API splits longer unquoted data itself
+		quoted := strings.HasPrefix(strings.TrimSpace(opts.Data), `"`)
+		strs, err := txtStrings(opts.Data)
+		if err != nil {
+			return invalid("data has %s", err)
+		}
+		total := 0
+		for _, str := range strs {
+			if quoted && len(str) > 255 {
+				return invalid("strings must be at most 255 characters, got %d", len(str))
+			}
+			total += len(str)
+		}
+		if total > maxTXTLength {
+			return invalid("data must be at most %d characters, got %d", maxTXTLength, total)
+		}
 	}
 
-	if opts.Port != "" {
-		params["port"] = opts.Port
+	return nil
+}
+
+// txtStrings splits TXT data into its character strings. Data made
+// of quoted strings, like "v=spf1 " "-all", gives each of them, and
+// anything else is a single string. Quoted data with an unterminated
+// string or text outside of the quotes is an error.
+func txtStrings(data string) ([]string, error) {
+	data = strings.TrimSpace(data)
+
+	if !strings.HasPrefix(data, `"`) {
+		return []string{data}, nil
 	}
 
-	if opts.Weight != "" {
-		params["weight"] = opts.Weight
+	var strs []string
+	var current []byte
+	quoted := false
+
+	for i := 0; i < len(data); i++ {
+		switch ch := data[i]; {
+		case ch == '\\' && quoted && i+1 < len(data):
+			i++
+			current = append(current, data[i])
+		case ch == '"' && quoted:
+			strs = append(strs, string(current))
+			current = current[:0]
+			quoted = false
+		case ch == '"':
+			quoted = true
+		case quoted:
+			current = append(current, ch)
+		case ch != ' ' && ch != '\t':
+			return nil, fmt.Errorf("text outside of quotes at %q", data[i:])
+		}
 	}
 
-	req, err := c.NewRequest(params, "POST", fmt.Sprintf("/domains/%s/records", domain))
+	if quoted {
+		return nil, fmt.Errorf("unbalanced quotes")
+	}
+
+	return strs, nil
+}
+
+// recordParams builds the request parameters of a record, leaving
+// out the fields that aren't set
+func recordParams(fields map[string]string) map[string]string {
+	params := make(map[string]string)
+
+	for k, v := range fields {
+		if v != "" {
+			params[k] = v
+		}
+	}
+
+	return params
+}
+
+// CreateRecord creates a record from the parameters specified and
+// returns an error if it fails, or if they don't pass Validate. If
+// no error and the name is returned, the Record was succesfully created.
+func (c *Client) CreateRecord(domain string, opts *CreateRecord) (string, error) {
+	return c.CreateRecordContext(context.Background(), domain, opts)
+}
+
+// CreateRecordContext is the context-aware form of CreateRecord.
+func (c *Client) CreateRecordContext(ctx context.Context, domain string, opts *CreateRecord) (string, error) {
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
+
+	// Make the request parameters
+	params := recordParams(map[string]string{
+		"name":     opts.Name,
+		"data":     opts.Data,
+		"priority": opts.Priority,
+		"port":     opts.Port,
+		"weight":   opts.Weight,
+		"ttl":      opts.TTL,
+		"flags":    opts.Flags,
+		"tag":      opts.Tag,
+	})
+
+	params["type"] = opts.Type
+
+	req, err := c.NewRequestContext(ctx, params, "POST", fmt.Sprintf("/domains/%s/records", domain))
 	if err != nil {
 		return "", err
 	}
 
-	resp, err := checkResp(c.Http.Do(req))
+	resp, err := checkResp(c.do(req))
 
 	if err != nil {
-		return "", fmt.Errorf("Error creating record: %s", err)
+		return "", fmt.Errorf("Error creating record: %w", err)
 	}
 
 	record := new(RecordResponse)
@@ -93,57 +283,139 @@ func (c *Client) CreateRecord(domain string, opts *CreateRecord) (string, error)
 	err = decodeBody(resp, &record)
 
 	if err != nil {
-		return "", fmt.Errorf("Error parsing record response: %s", err)
+		return "", fmt.Errorf("Error parsing record response: %w", err)
 	}
 
 	// The request was successful
 	return record.Record.StringId(), nil
 }
 
+// ListRecords contains the parameters to filter the list of records
+// of a domain. The zero value lists every record.
+type ListRecords struct {
+	Type string // Only list the records of this type
+	Name string // Only list the records with this fully qualified name
+}
+
+// endpoint returns the list endpoint of the domain matching the filters
+func (opts *ListRecords) endpoint(domain string) string {
+	endpoint := fmt.Sprintf("/domains/%s/records", domain)
+
+	if opts == nil {
+		return endpoint
+	}
+
+	if opts.Type != "" {
+		endpoint = addQuery(endpoint, "type", opts.Type)
+	}
+
+	if opts.Name != "" {
+		endpoint = addQuery(endpoint, "name", opts.Name)
+	}
+
+	return endpoint
+}
+
+// RetrieveRecords gets the list of records of the domain matching the
+// filters, following every page, and an error. An error will be
+// returned for failed requests with a nil slice.
+func (c *Client) RetrieveRecords(domain string, opts *ListRecords) ([]Record, error) {
+	return c.RetrieveRecordsContext(context.Background(), domain, opts)
+}
+
+// RetrieveRecordsContext is the context-aware form of RetrieveRecords.
+func (c *Client) RetrieveRecordsContext(ctx context.Context, domain string, opts *ListRecords) ([]Record, error) {
+	records, err := collect(c.IterateRecordsContext(ctx, domain, opts))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving records: %w", err)
+	}
+
+	// The request was successful
+	return records, nil
+}
+
+// IterateRecords returns an Iterator over the records of the domain
+// matching the filters.
+func (c *Client) IterateRecords(domain string, opts *ListRecords) *Iterator[Record] {
+	return c.IterateRecordsContext(context.Background(), domain, opts)
+}
+
+// IterateRecordsContext is the context-aware form of IterateRecords.
+func (c *Client) IterateRecordsContext(ctx context.Context, domain string, opts *ListRecords) *Iterator[Record] {
+	return newIterator[Record](ctx, c, opts.endpoint(domain), "domain_records")
+}
+
 // DestroyRecord destroys a record by the ID specified and
 // returns an error if it fails. If no error is returned,
 // the Record was succesfully destroyed.
 func (c *Client) DestroyRecord(domain string, id string) error {
-	req, err := c.NewRequest(map[string]string{}, "DELETE", fmt.Sprintf("/domains/%s/records/%s", domain, id))
+	return c.DestroyRecordContext(context.Background(), domain, id)
+}
+
+// DestroyRecordContext is the context-aware form of DestroyRecord.
+func (c *Client) DestroyRecordContext(ctx context.Context, domain string, id string) error {
+	req, err := c.NewRequestContext(ctx, map[string]string{}, "DELETE", fmt.Sprintf("/domains/%s/records/%s", domain, id))
 
 	if err != nil {
 		return err
 	}
 
-	_, err = checkResp(c.Http.Do(req))
+	_, err = checkResp(c.do(req))
 
 	if err != nil {
-		return fmt.Errorf("Error destroying record: %s", err)
+		return fmt.Errorf("Error destroying record: %w", err)
 	}
 
 	// The request was successful
 	return nil
 }
 
-// UpdateRecord contains the request parameters to create a new
-// record.
+// UpdateRecord contains the request parameters to update a
+// record. Only the fields that are set are changed.
 type UpdateRecord struct {
-	Name string
+	Type     string
+	Name     string
+	Data     string
+	Priority string
+	Port     string
+	Weight   string
+	TTL      string
+	Flags    string
+	Tag      string
 }
 
-// UpdateRecord destroys a record by the ID specified and
+// UpdateRecord updates a record by the ID specified and
 // returns an error if it fails. If no error is returned,
 // the Record was succesfully updated.
 func (c *Client) UpdateRecord(domain string, id string, opts *UpdateRecord) error {
-	params := make(map[string]string)
-
-	params["name"] = opts.Name
+	return c.UpdateRecordContext(context.Background(), domain, id, opts)
+}
 
-	req, err := c.NewRequest(params, "PUT", fmt.Sprintf("/domains/%s/records/%s", domain, id))
+// UpdateRecordContext is the context-aware form of UpdateRecord.
+func (c *Client) UpdateRecordContext(ctx context.Context, domain string, id string, opts *UpdateRecord) error {
+	params := recordParams(map[string]string{
+		"type":     opts.Type,
+		"name":     opts.Name,
+		"data":     opts.Data,
+		"priority": opts.Priority,
+		"port":     opts.Port,
+		"weight":   opts.Weight,
+		"ttl":      opts.TTL,
+		"flags":    opts.Flags,
+		"tag":      opts.Tag,
+	})
+
+	req, err := c.NewRequestContext(ctx, params, "PUT", fmt.Sprintf("/domains/%s/records/%s", domain, id))
 
 	if err != nil {
 		return err
 	}
 
-	_, err = checkResp(c.Http.Do(req))
+	_, err = checkResp(c.do(req))
 
 	if err != nil {
-		return fmt.Errorf("Error updating record: %s", err)
+		return fmt.Errorf("Error updating record: %w", err)
 	}
 
 	// The request was successful
@@ -154,15 +426,20 @@ func (c *Client) UpdateRecord(domain string, id string, opts *UpdateRecord) erro
 // returns a Record and an error. An error will be returned for failed
 // requests with a nil Record.
 func (c *Client) RetrieveRecord(domain string, id string) (Record, error) {
-	req, err := c.NewRequest(map[string]string{}, "GET", fmt.Sprintf("/domains/%s/records/%s", domain, id))
+	return c.RetrieveRecordContext(context.Background(), domain, id)
+}
+
+// RetrieveRecordContext is the context-aware form of RetrieveRecord.
+func (c *Client) RetrieveRecordContext(ctx context.Context, domain string, id string) (Record, error) {
+	req, err := c.NewRequestContext(ctx, map[string]string{}, "GET", fmt.Sprintf("/domains/%s/records/%s", domain, id))
 
 	if err != nil {
 		return Record{}, err
 	}
 
-	resp, err := checkResp(c.Http.Do(req))
+	resp, err := checkResp(c.do(req))
 	if err != nil {
-		return Record{}, fmt.Errorf("Error destroying record: %s", err)
+		return Record{}, fmt.Errorf("Error destroying record: %w", err)
 	}
 
 	record := new(RecordResponse)
@@ -170,7 +447,7 @@ func (c *Client) RetrieveRecord(domain string, id string) (Record, error) {
 	err = decodeBody(resp, record)
 
 	if err != nil {
-		return Record{}, fmt.Errorf("Error decoding record response: %s", err)
+		return Record{}, fmt.Errorf("Error decoding record response: %w", err)
 	}
 
 	// The request was successful
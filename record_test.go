@@ -1,6 +1,8 @@
 package digitalocean
 
 import (
+	"io/ioutil"
+	"strings"
 	"testing"
 
 	. "github.com/motain/gocheck"
@@ -38,6 +40,7 @@ func (s *S) Test_RetrieveRecord(c *C) {
 	c.Assert(record.Name, Equals, "subdomain")
 	c.Assert(record.StringId(), Equals, "16")
 	c.Assert(record.StringPort(), Equals, "0")
+	c.Assert(record.StringTTL(), Equals, "1800")
 }
 
 func (s *S) Test_DestroyRecord(c *C) {
@@ -64,6 +67,147 @@ func (s *S) Test_UpdateRecord(c *C) {
 	c.Assert(err, IsNil)
 }
 
+func (s *S) Test_UpdateRecord_allFields(c *C) {
+	testServer.Response(200, nil, recordExample)
+
+	opts := UpdateRecord{
+		Type:  "CAA",
+		Name:  "@",
+		Data:  "letsencrypt.org",
+		TTL:   "3600",
+		Flags: "0",
+		Tag:   "issue",
+	}
+
+	err := s.client.UpdateRecord("example.com", "25", &opts)
+
+	req := testServer.WaitRequest()
+
+	body, _ := ioutil.ReadAll(req.Body)
+
+	c.Assert(err, IsNil)
+	c.Assert(req.Method, Equals, "PUT")
+	c.Assert(strings.TrimSpace(string(body)), Equals, `{"data":"letsencrypt.org","flags":"0","name":"@","tag":"issue","ttl":"3600","type":"CAA"}`)
+}
+
+func (s *S) Test_UpdateRecord_onlySetFields(c *C) {
+	testServer.Response(200, nil, recordExample)
+
+	err := s.client.UpdateRecord("example.com", "25", &UpdateRecord{Priority: "20"})
+
+	req := testServer.WaitRequest()
+
+	body, _ := ioutil.ReadAll(req.Body)
+
+	c.Assert(err, IsNil)
+	c.Assert(strings.TrimSpace(string(body)), Equals, `{"priority":"20"}`)
+}
+
+func (s *S) Test_RetrieveRecords(c *C) {
+	testServer.Response(200, nil, recordsExample)
+
+	records, err := s.client.RetrieveRecords("example.com", &ListRecords{Type: "MX", Name: "example.com"})
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/domains/example.com/records")
+	c.Assert(req.URL.Query().Get("type"), Equals, "MX")
+	c.Assert(req.URL.Query().Get("name"), Equals, "example.com")
+	c.Assert(records, HasLen, 2)
+	c.Assert(records[0].Priority, Equals, 10)
+	c.Assert(records[0].TTL, Equals, 1800)
+	c.Assert(records[1].StringPriority(), Equals, "20")
+}
+
+func (s *S) Test_CreateRecord_invalid(c *C) {
+	opts := CreateRecord{
+		Type: "A",
+		Name: "foobar",
+		Data: "2001:db8::1",
+	}
+
+	_, err := s.client.CreateRecord("example.com", &opts)
+
+	c.Assert(err, ErrorMatches, `Error validating record: A record data must be an IPv4 address, got "2001:db8::1"`)
+
+	// No request was made for the invalid record
+	testServer.Response(200, nil, recordExample)
+
+	_, err = s.client.RetrieveRecord("example.com", "16")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.Method, Equals, "GET")
+}
+
+func TestCreateRecord_Validate(t *testing.T) {
+	long := strings.Repeat("a", 256)
+	dkim := "v=DKIM1; k=rsa; p=" + strings.Repeat("MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8A", 12) + "IDAQAB"
+
+	cases := []struct {
+		opts CreateRecord
+		err  string
+	}{
+		{CreateRecord{Type: "A", Data: "10.0.0.1"}, ""},
+		{CreateRecord{Type: "A", Data: "10.0.0.256"}, `A record data must be an IPv4 address, got "10.0.0.256"`},
+		{CreateRecord{Type: "A", Data: "::ffff:10.0.0.1"}, `A record data must be an IPv4 address, got "::ffff:10.0.0.1"`},
+		{CreateRecord{Type: "AAAA", Data: "2001:db8::ff00:42:8329"}, ""},
+		{CreateRecord{Type: "AAAA", Data: "10.0.0.1"}, `AAAA record data must be an IPv6 address, got "10.0.0.1"`},
+		{CreateRecord{Type: "CNAME", Data: "www.example.com."}, ""},
+		{CreateRecord{Type: "CNAME", Data: "@"}, ""},
+		{CreateRecord{Type: "CNAME", Data: "www.example.com"}, `CNAME record data must be a fully qualified name ending with a dot, got "www.example.com"`},
+		{CreateRecord{Type: "MX", Data: "mx.example.com.", Priority: "10"}, ""},
+		{CreateRecord{Type: "MX", Data: "mx.example.com."}, "MX record priority is required"},
+		{CreateRecord{Type: "MX", Data: "mx.example.com.", Priority: "ten"}, `priority must be a number between 0 and 65535, got "ten"`},
+		{CreateRecord{Type: "SRV", Data: "sip.example.com.", Priority: "10", Port: "5060", Weight: "5"}, ""},
+		{CreateRecord{Type: "SRV", Data: "sip.example.com.", Priority: "10", Port: "5060"}, "SRV record priority, port and weight are required"},
+		{CreateRecord{Type: "SRV", Data: "sip.example.com.", Priority: "10", Port: "70000", Weight: "5"}, `port must be a number between 0 and 65535, got "70000"`},
+		{CreateRecord{Type: "CAA", Data: "letsencrypt.org", Flags: "0", Tag: "issue"}, ""},
+		{CreateRecord{Type: "CAA", Data: "letsencrypt.org", Tag: "issue"}, "CAA record flags is required"},
+		{CreateRecord{Type: "CAA", Data: "letsencrypt.org", Flags: "0", Tag: "bogus"}, `CAA record tag must be issue, issuewild or iodef, got "bogus"`},
+		{CreateRecord{Type: "TXT", Data: "v=spf1 -all"}, ""},
+		{CreateRecord{Type: "TXT", Data: `"` + long[:255] + `" "` + long[:255] + `"`}, ""},
+		{CreateRecord{Type: "TXT", Data: long}, ""},
+		{CreateRecord{Type: "TXT", Data: dkim}, ""},
+		{CreateRecord{Type: "TXT", Data: strings.Repeat("a", 513)}, "TXT record data must be at most 512 characters, got 513"},
+		{CreateRecord{Type: "TXT", Data: `"` + long[:255] + `" "` + long[:255] + `" "aaa"`}, "TXT record data must be at most 512 characters, got 513"},
+		{CreateRecord{Type: "TXT", Data: `"` + long + `"`}, "TXT record strings must be at most 255 characters, got 256"},
+		{CreateRecord{Type: "TXT"}, "TXT record data is required"},
+		{CreateRecord{Type: "TXT", Data: `"abc`}, "TXT record data has unbalanced quotes"},
+		{CreateRecord{Type: "TXT", Data: `"abc" trailing`}, `TXT record data has text outside of quotes at "trailing"`},
+		{CreateRecord{Type: "mx", Data: "mx.example.com."}, "MX record priority is required"},
+		{CreateRecord{Type: "srv", Data: "sip.example.com.", Priority: "10"}, "SRV record priority, port and weight are required"},
+		{CreateRecord{Type: "a", Data: "10.0.0.1"}, ""},
+		{CreateRecord{Type: "NS", Data: "ns1.example.com.", TTL: "10"}, "ttl must be at least 30, got 10"},
+		{CreateRecord{Data: "10.0.0.1"}, "type is required"},
+	}
+
+	for _, tc := range cases {
+		err := tc.opts.Validate()
+
+		if tc.err == "" {
+			if err != nil {
+				t.Fatalf("bad: %#v: %s", tc.opts, err)
+			}
+			continue
+		}
+
+		if err == nil || err.Error() != "Error validating record: "+tc.err {
+			t.Fatalf("bad: %#v: %v", tc.opts, err)
+		}
+	}
+}
+
+func TestTxtStrings(t *testing.T) {
+	strs, err := txtStrings(`"v=DKIM1; k=rsa; " "p=MIGf\"MA0"`)
+
+	if err != nil || len(strs) != 2 || strs[0] != "v=DKIM1; k=rsa; " || strs[1] != `p=MIGf"MA0` {
+		t.Fatalf("bad: %#v", strs)
+	}
+}
+
 var recordErrorExample = `{
   "id": "unprocessable_entity",
   "message": "Type can't be blank."
@@ -77,6 +221,42 @@ var recordExample = `{
     "data": "2001:db8::ff00:42:8329",
     "priority": null,
     "port": null,
-    "weight": null
+    "weight": null,
+    "ttl": 1800,
+    "flags": null,
+    "tag": null
+  }
+}`
+
+var recordsExample = `{
+  "domain_records": [
+    {
+      "id": 28448432,
+      "type": "MX",
+      "name": "@",
+      "data": "mx1.example.com",
+      "priority": 10,
+      "port": null,
+      "ttl": 1800,
+      "weight": null,
+      "flags": null,
+      "tag": null
+    },
+    {
+      "id": 28448433,
+      "type": "MX",
+      "name": "@",
+      "data": "mx2.example.com",
+      "priority": 20,
+      "port": null,
+      "ttl": 1800,
+      "weight": null,
+      "flags": null,
+      "tag": null
+    }
+  ],
+  "links": {},
+  "meta": {
+    "total": 2
   }
 }`
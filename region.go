@@ -0,0 +1,60 @@
+package digitalocean
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Region is used to represent a DigitalOcean region, as embedded
+// in Droplets and other resources.
+type Region struct {
+	Slug      string   `json:"slug"`
+	Name      string   `json:"name"`
+	Sizes     []string `json:"sizes"`
+	Available bool     `json:"available"`
+	Features  []string `json:"features"`
+}
+
+// UnmarshalJSON accepts either a full region object or, as some
+// responses embed it, just the slug of the region
+func (r *Region) UnmarshalJSON(data []byte) error {
+	var slug string
+	if err := json.Unmarshal(data, &slug); err == nil {
+		*r = Region{Slug: slug}
+		return nil
+	}
+
+	// Decode through another type so we don't recurse into this method
+	type region Region
+	return json.Unmarshal(data, (*region)(r))
+}
+
+// RetrieveRegions gets the list of all regions, following every page,
+// and an error. An error will be returned for failed requests with a
+// nil slice.
+func (c *Client) RetrieveRegions() ([]Region, error) {
+	return c.RetrieveRegionsContext(context.Background())
+}
+
+// RetrieveRegionsContext is the context-aware form of RetrieveRegions.
+func (c *Client) RetrieveRegionsContext(ctx context.Context) ([]Region, error) {
+	regions, err := collect(c.IterateRegionsContext(ctx))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving regions: %w", err)
+	}
+
+	// The request was successful
+	return regions, nil
+}
+
+// IterateRegions returns an Iterator over all regions.
+func (c *Client) IterateRegions() *Iterator[Region] {
+	return c.IterateRegionsContext(context.Background())
+}
+
+// IterateRegionsContext is the context-aware form of IterateRegions.
+func (c *Client) IterateRegionsContext(ctx context.Context) *Iterator[Region] {
+	return newIterator[Region](ctx, c, "/regions", "regions")
+}
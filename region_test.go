@@ -0,0 +1,49 @@
+package digitalocean
+
+import (
+	"testing"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestRegion(t *testing.T) {
+	TestingT(t)
+}
+
+func (s *S) Test_RetrieveRegions(c *C) {
+	testServer.Response(200, nil, regionsExample)
+
+	regions, err := s.client.RetrieveRegions()
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/regions")
+	c.Assert(regions, HasLen, 2)
+	c.Assert(regions[0].Slug, Equals, "nyc1")
+	c.Assert(regions[0].Sizes, DeepEquals, []string{"512mb", "1gb"})
+	c.Assert(regions[1].Available, Equals, false)
+}
+
+var regionsExample = `{
+  "regions": [
+    {
+      "slug": "nyc1",
+      "name": "New York 1",
+      "sizes": ["512mb", "1gb"],
+      "available": true,
+      "features": ["virtio", "private_networking", "backups", "ipv6"]
+    },
+    {
+      "slug": "sfo1",
+      "name": "San Francisco 1",
+      "sizes": [],
+      "available": false,
+      "features": ["virtio", "backups"]
+    }
+  ],
+  "links": {},
+  "meta": {
+    "total": 2
+  }
+}`
@@ -0,0 +1,175 @@
+package digitalocean
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the Client retries requests that fail with
+// a 429, a 5xx or a network error. Only idempotent methods are retried,
+// unless the request context was marked with RetrySafe.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first
+	MaxAttempts int
+
+	// MinBackoff is the wait before the first retry. It doubles on
+	// every following attempt.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the wait between two attempts, including any
+	// wait requested through Retry-After. Zero means no cap.
+	MaxBackoff time.Duration
+
+	// Jitter is the fraction, between 0 and 1, of each backoff that
+	// is randomized so concurrent clients don't retry in lockstep
+	Jitter float64
+}
+
+// DefaultRetryPolicy returns a RetryPolicy suitable for most uses:
+// four attempts, starting at half a second and capped at 30 seconds.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.5,
+	}
+}
+
+type retrySafeKey struct{}
+
+// RetrySafe marks requests made with the returned context as safe to
+// retry, even if their method isn't idempotent. Use it for calls like
+// CreateDropletContext where a duplicate is acceptable.
+func RetrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, true)
+}
+
+// canRetry returns whether the request may be sent more than once
+func canRetry(req *http.Request) bool {
+	if safe, ok := req.Context().Value(retrySafeKey{}).(bool); ok && safe {
+		return req.Body == nil || req.GetBody != nil
+	}
+
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return req.Body == nil || req.GetBody != nil
+	}
+
+	return false
+}
+
+// shouldRetry returns whether the outcome of an attempt is worth
+// trying again
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	switch i := resp.StatusCode; {
+	case i == 429:
+		return true
+	case i >= 500:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff returns how long to wait after the given failed attempt
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := p.MinBackoff << uint(attempt-1)
+
+	if wait < p.MinBackoff {
+		// Overflowed, so just use the cap
+		wait = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 && wait > 0 {
+		spread := time.Duration(float64(wait) * p.Jitter)
+		wait = wait - spread + time.Duration(rand.Int63n(int64(spread)+1))
+	}
+
+	if resp != nil {
+		if after, ok := retryAfter(resp.Header.Get("Retry-After"), time.Now()); ok && after > wait {
+			wait = after
+		}
+	}
+
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+
+	return wait
+}
+
+// retryAfter parses a Retry-After header, which is either a number
+// of seconds or an HTTP date
+func retryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		if t.Before(now) {
+			return 0, true
+		}
+		return t.Sub(now), true
+	}
+
+	return 0, false
+}
+
+// do sends the request, retrying it according to the client's
+// RetryPolicy. The last response or error is returned as is, for
+// checkResp to interpret.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	p := c.Retry
+
+	if p == nil || p.MaxAttempts <= 1 || !canRetry(req) {
+		return c.send(req)
+	}
+
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(req)
+
+		if attempt >= p.MaxAttempts || !shouldRetry(resp, err) || ctx.Err() != nil {
+			return resp, err
+		}
+
+		wait := p.backoff(attempt, resp)
+
+		// We're throwing this response away, so release the connection
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+
+		// Rewind the body for the next attempt
+		next := req.Clone(ctx)
+		if req.GetBody != nil {
+			if next.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+		req = next
+	}
+}
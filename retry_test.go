@@ -0,0 +1,128 @@
+package digitalocean
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestRetry(t *testing.T) {
+	TestingT(t)
+}
+
+func retryClient(c *C) *Client {
+	client, err := NewClient("foobar")
+	c.Assert(err, IsNil)
+
+	client.URL = "http://localhost:4444"
+	client.Retry = &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  10 * time.Millisecond,
+	}
+
+	return client
+}
+
+func (s *S) Test_Retry_serverError(c *C) {
+	testServer.Response(503, nil, "")
+	testServer.Response(200, nil, dropletExample)
+
+	droplet, err := retryClient(c).RetrieveDroplet("25")
+
+	_ = testServer.WaitRequests(2)
+
+	c.Assert(err, IsNil)
+	c.Assert(droplet.StringId(), Equals, "25")
+}
+
+func (s *S) Test_Retry_rateLimited(c *C) {
+	testServer.Response(429, map[string]string{"Retry-After": "0"}, "")
+	testServer.Response(204, nil, "")
+
+	err := retryClient(c).DestroyDroplet("25")
+
+	_ = testServer.WaitRequests(2)
+
+	c.Assert(err, IsNil)
+}
+
+func (s *S) Test_Retry_givesUp(c *C) {
+	testServer.Responses(3, 500, nil, "")
+
+	_, err := retryClient(c).RetrieveDroplet("25")
+
+	_ = testServer.WaitRequests(3)
+
+	c.Assert(err, ErrorMatches, "Error retrieving droplet: API Error: 500 Internal Server Error")
+}
+
+func (s *S) Test_Retry_notIdempotent(c *C) {
+	testServer.Response(503, nil, "")
+
+	_, err := retryClient(c).CreateDroplet(&CreateDroplet{Name: "foobar"})
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, ErrorMatches, "Error creating droplet: API Error: 503 Service Unavailable")
+}
+
+func (s *S) Test_Retry_markedSafe(c *C) {
+	testServer.Response(503, nil, "")
+	testServer.Response(202, nil, dropletExample)
+
+	ctx := RetrySafe(context.Background())
+	id, err := retryClient(c).CreateDropletContext(ctx, &CreateDroplet{Name: "foobar"})
+
+	reqs := testServer.WaitRequests(2)
+
+	c.Assert(err, IsNil)
+	c.Assert(id, Equals, "25")
+	c.Assert(reqs[1].Method, Equals, "POST")
+	c.Assert(reqs[1].ContentLength > 0, Equals, true)
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2015, 3, 5, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		value string
+		wait  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"5", 5 * time.Second, true},
+		{"-1", 0, false},
+		{"Thu, 05 Mar 2015 12:00:10 GMT", 10 * time.Second, true},
+		{"Thu, 05 Mar 2015 11:00:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+
+	for _, tc := range cases {
+		wait, ok := retryAfter(tc.value, now)
+		if wait != tc.wait || ok != tc.ok {
+			t.Fatalf("bad: %q: %v %v", tc.value, wait, ok)
+		}
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{
+		MinBackoff: time.Second,
+		MaxBackoff: 5 * time.Second,
+	}
+
+	if wait := p.backoff(1, nil); wait != time.Second {
+		t.Fatalf("bad: %v", wait)
+	}
+
+	if wait := p.backoff(2, nil); wait != 2*time.Second {
+		t.Fatalf("bad: %v", wait)
+	}
+
+	if wait := p.backoff(10, nil); wait != 5*time.Second {
+		t.Fatalf("bad: %v", wait)
+	}
+}
@@ -0,0 +1,49 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+)
+
+// Size is used to represent a Droplet size. Memory is in megabytes,
+// Disk in gigabytes and Transfer in terabytes.
+type Size struct {
+	Slug         string   `json:"slug"`
+	Memory       int      `json:"memory"`
+	Vcpus        int      `json:"vcpus"`
+	Disk         int      `json:"disk"`
+	Transfer     float64  `json:"transfer"`
+	PriceMonthly float64  `json:"price_monthly"`
+	PriceHourly  float64  `json:"price_hourly"`
+	Regions      []string `json:"regions"`
+	Available    bool     `json:"available"`
+}
+
+// RetrieveSizes gets the list of all sizes, following every page,
+// and an error. An error will be returned for failed requests with
+// a nil slice.
+func (c *Client) RetrieveSizes() ([]Size, error) {
+	return c.RetrieveSizesContext(context.Background())
+}
+
+// RetrieveSizesContext is the context-aware form of RetrieveSizes.
+func (c *Client) RetrieveSizesContext(ctx context.Context) ([]Size, error) {
+	sizes, err := collect(c.IterateSizesContext(ctx))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving sizes: %w", err)
+	}
+
+	// The request was successful
+	return sizes, nil
+}
+
+// IterateSizes returns an Iterator over all sizes.
+func (c *Client) IterateSizes() *Iterator[Size] {
+	return c.IterateSizesContext(context.Background())
+}
+
+// IterateSizesContext is the context-aware form of IterateSizes.
+func (c *Client) IterateSizesContext(ctx context.Context) *Iterator[Size] {
+	return newIterator[Size](ctx, c, "/sizes", "sizes")
+}
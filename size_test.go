@@ -0,0 +1,73 @@
+package digitalocean
+
+import (
+	"testing"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestSize(t *testing.T) {
+	TestingT(t)
+}
+
+func (s *S) Test_RetrieveSizes(c *C) {
+	testServer.Response(200, nil, sizesExample)
+
+	sizes, err := s.client.RetrieveSizes()
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/sizes")
+	c.Assert(sizes, HasLen, 3)
+	c.Assert(sizes[0].Slug, Equals, "512mb")
+	c.Assert(sizes[0].Memory, Equals, 512)
+	c.Assert(sizes[0].Vcpus, Equals, 1)
+	c.Assert(sizes[0].Disk, Equals, 20)
+	c.Assert(sizes[0].Transfer, Equals, 1.0)
+	c.Assert(sizes[0].PriceMonthly, Equals, 5.0)
+	c.Assert(sizes[0].PriceHourly, Equals, 0.00744)
+	c.Assert(sizes[0].Regions, DeepEquals, []string{"nyc1", "sfo1"})
+}
+
+var sizesExample = `{
+  "sizes": [
+    {
+      "slug": "512mb",
+      "memory": 512,
+      "vcpus": 1,
+      "disk": 20,
+      "transfer": 1.0,
+      "price_monthly": 5.0,
+      "price_hourly": 0.00744,
+      "regions": ["nyc1", "sfo1"],
+      "available": true
+    },
+    {
+      "slug": "1gb",
+      "memory": 1024,
+      "vcpus": 1,
+      "disk": 30,
+      "transfer": 2.0,
+      "price_monthly": 10.0,
+      "price_hourly": 0.01488,
+      "regions": ["nyc1"],
+      "available": true
+    },
+    {
+      "slug": "2gb",
+      "memory": 2048,
+      "vcpus": 2,
+      "disk": 40,
+      "transfer": 3.0,
+      "price_monthly": 20.0,
+      "price_hourly": 0.02976,
+      "regions": ["nyc1"],
+      "available": true
+    }
+  ],
+  "links": {},
+  "meta": {
+    "total": 3
+  }
+}`
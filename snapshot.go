@@ -0,0 +1,118 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type SnapshotResponse struct {
+	Snapshot Snapshot `json:"snapshot"`
+}
+
+// Snapshot is used to represent a snapshot of a droplet or a
+// volume. ResourceType is either "droplet" or "volume".
+type Snapshot struct {
+	Id            string    `json:"id"`
+	Name          string    `json:"name"`
+	CreatedAt     time.Time `json:"created_at"`
+	Regions       []string  `json:"regions"`
+	ResourceId    string    `json:"resource_id"`
+	ResourceType  string    `json:"resource_type"`
+	MinDiskSize   int       `json:"min_disk_size"`
+	SizeGigabytes float64   `json:"size_gigabytes"`
+}
+
+// RetrieveSnapshots gets the list of snapshots, following every page,
+// and an error. resourceType filters them to "droplet" or "volume"
+// snapshots, and an empty string lists both. An error will be returned
+// for failed requests with a nil slice.
+func (c *Client) RetrieveSnapshots(resourceType string) ([]Snapshot, error) {
+	return c.RetrieveSnapshotsContext(context.Background(), resourceType)
+}
+
+// RetrieveSnapshotsContext is the context-aware form of RetrieveSnapshots.
+func (c *Client) RetrieveSnapshotsContext(ctx context.Context, resourceType string) ([]Snapshot, error) {
+	snapshots, err := collect(c.IterateSnapshotsContext(ctx, resourceType))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving snapshots: %w", err)
+	}
+
+	// The request was successful
+	return snapshots, nil
+}
+
+// IterateSnapshots returns an Iterator over the snapshots of the
+// resource type specified, or of all types if it is empty.
+func (c *Client) IterateSnapshots(resourceType string) *Iterator[Snapshot] {
+	return c.IterateSnapshotsContext(context.Background(), resourceType)
+}
+
+// IterateSnapshotsContext is the context-aware form of IterateSnapshots.
+func (c *Client) IterateSnapshotsContext(ctx context.Context, resourceType string) *Iterator[Snapshot] {
+	endpoint := "/snapshots"
+
+	if resourceType != "" {
+		endpoint = addQuery(endpoint, "resource_type", resourceType)
+	}
+
+	return newIterator[Snapshot](ctx, c, endpoint, "snapshots")
+}
+
+// RetrieveSnapshot gets a snapshot by the ID specified and returns
+// a Snapshot and an error. An error will be returned for failed
+// requests with a nil Snapshot.
+func (c *Client) RetrieveSnapshot(id string) (Snapshot, error) {
+	return c.RetrieveSnapshotContext(context.Background(), id)
+}
+
+// RetrieveSnapshotContext is the context-aware form of RetrieveSnapshot.
+func (c *Client) RetrieveSnapshotContext(ctx context.Context, id string) (Snapshot, error) {
+	req, err := c.NewRequestContext(ctx, nil, "GET", fmt.Sprintf("/snapshots/%s", id))
+
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	resp, err := checkResp(c.do(req))
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("Error retrieving snapshot: %w", err)
+	}
+
+	snapshot := new(SnapshotResponse)
+
+	err = decodeBody(resp, snapshot)
+
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("Error decoding snapshot response: %w", err)
+	}
+
+	// The request was successful
+	return snapshot.Snapshot, nil
+}
+
+// DestroySnapshot destroys a snapshot by the ID specified and
+// returns an error if it fails. If no error is returned,
+// the Snapshot was succesfully destroyed.
+func (c *Client) DestroySnapshot(id string) error {
+	return c.DestroySnapshotContext(context.Background(), id)
+}
+
+// DestroySnapshotContext is the context-aware form of DestroySnapshot.
+func (c *Client) DestroySnapshotContext(ctx context.Context, id string) error {
+	req, err := c.NewRequestContext(ctx, nil, "DELETE", fmt.Sprintf("/snapshots/%s", id))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = checkResp(c.do(req))
+
+	if err != nil {
+		return fmt.Errorf("Error destroying snapshot: %w", err)
+	}
+
+	// The request was successful
+	return nil
+}
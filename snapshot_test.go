@@ -0,0 +1,84 @@
+package digitalocean
+
+import (
+	"testing"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestSnapshot(t *testing.T) {
+	TestingT(t)
+}
+
+func (s *S) Test_RetrieveSnapshots(c *C) {
+	testServer.Response(200, nil, snapshotsExample)
+
+	snapshots, err := s.client.RetrieveSnapshots("")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/snapshots")
+	c.Assert(req.URL.Query().Get("resource_type"), Equals, "")
+	c.Assert(snapshots, HasLen, 2)
+	c.Assert(snapshots[0].ResourceType, Equals, "droplet")
+	c.Assert(snapshots[0].ResourceId, Equals, "200776916")
+	c.Assert(snapshots[0].SizeGigabytes, Equals, 0.34)
+	c.Assert(snapshots[1].ResourceType, Equals, "volume")
+}
+
+func (s *S) Test_RetrieveSnapshots_resourceType(c *C) {
+	testServer.Response(200, nil, snapshotsExample)
+
+	_, err := s.client.RetrieveSnapshots("volume")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Query().Get("resource_type"), Equals, "volume")
+}
+
+func (s *S) Test_RetrieveSnapshot(c *C) {
+	testServer.Response(200, nil, volumeSnapshotExample)
+
+	snapshot, err := s.client.RetrieveSnapshot("8fa70202-873f-11e6-8b68-000f533176b1")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/snapshots/8fa70202-873f-11e6-8b68-000f533176b1")
+	c.Assert(snapshot.Name, Equals, "big-data-snapshot1475261774")
+	c.Assert(snapshot.MinDiskSize, Equals, 10)
+}
+
+func (s *S) Test_DestroySnapshot(c *C) {
+	testServer.Response(204, nil, "")
+
+	err := s.client.DestroySnapshot("6372321")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.Method, Equals, "DELETE")
+	c.Assert(req.URL.Path, Equals, "/snapshots/6372321")
+}
+
+var snapshotsExample = `{
+  "snapshots": [
+    {
+      "id": "6372321",
+      "name": "web-01-1595954862243",
+      "created_at": "2020-07-28T16:47:44Z",
+      "regions": ["nyc3", "sfo3"],
+      "resource_id": "200776916",
+      "resource_type": "droplet",
+      "min_disk_size": 25,
+      "size_gigabytes": 0.34
+    },
+    ` + volumeSnapshotJSON + `
+  ],
+  "links": {},
+  "meta": {
+    "total": 2
+  }
+}`
@@ -1,6 +1,7 @@
 package digitalocean
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 )
@@ -29,19 +30,41 @@ func (k *SSHKey) StringId() string {
 }
 
 // CreateSSHKey creates an SSH key from the parameters specified and returns an
-// error if it fails. If no error and the SSH key is returned, it was
+// error if it fails. The public key is parsed with ParsePublicKey before
+// any request is made. If no error and the SSH key is returned, it was
 // succesfully created.
 func (c *Client) CreateSSHKey(opts *CreateSSHKey) (string, error) {
-	req, err := c.NewRequest(opts, "POST", "/account/keys")
+	return c.CreateSSHKeyContext(context.Background(), opts)
+}
+
+// CreateSSHKeyContext is the context-aware form of CreateSSHKey.
+func (c *Client) CreateSSHKeyContext(ctx context.Context, opts *CreateSSHKey) (string, error) {
+	sshKey, err := c.createSSHKey(ctx, opts)
 
 	if err != nil {
 		return "", err
 	}
 
-	resp, err := checkResp(c.Http.Do(req))
+	// The request was successful
+	return sshKey.StringId(), nil
+}
+
+// createSSHKey validates and creates an SSH key, returning the new key
+func (c *Client) createSSHKey(ctx context.Context, opts *CreateSSHKey) (SSHKey, error) {
+	if _, err := ParsePublicKey(opts.PublicKey); err != nil {
+		return SSHKey{}, err
+	}
+
+	req, err := c.NewRequestContext(ctx, opts, "POST", "/account/keys")
+
+	if err != nil {
+		return SSHKey{}, err
+	}
+
+	resp, err := checkResp(c.do(req))
 
 	if err != nil {
-		return "", fmt.Errorf("Error creating SSH key: %s", err)
+		return SSHKey{}, fmt.Errorf("Error creating SSH key: %w", err)
 	}
 
 	sshKey := new(sshKeyResponse)
@@ -49,25 +72,59 @@ func (c *Client) CreateSSHKey(opts *CreateSSHKey) (string, error) {
 	err = decodeBody(resp, &sshKey)
 
 	if err != nil {
-		return "", fmt.Errorf("Error parsing SSH key response: %s", err)
+		return SSHKey{}, fmt.Errorf("Error parsing SSH key response: %w", err)
 	}
 
-	// The request was successful
-	return sshKey.SSHKey.StringId(), nil
+	return sshKey.SSHKey, nil
+}
+
+// EnsureSSHKey returns the SSH key already registered with the
+// fingerprint of the public key specified, or creates it from the
+// parameters specified if there is none. An error will be returned
+// for failed requests with a nil SSHKey.
+func (c *Client) EnsureSSHKey(opts *CreateSSHKey) (SSHKey, error) {
+	return c.EnsureSSHKeyContext(context.Background(), opts)
 }
 
-// RetrieveSSHKey gets an SSH key by the ID specified and returns a SSHKey and
-// an error. An error will be returned for failed requests with a nil SSHKey.
+// EnsureSSHKeyContext is the context-aware form of EnsureSSHKey.
+func (c *Client) EnsureSSHKeyContext(ctx context.Context, opts *CreateSSHKey) (SSHKey, error) {
+	fingerprint, err := Fingerprint(opts.PublicKey)
+
+	if err != nil {
+		return SSHKey{}, err
+	}
+
+	sshKey, err := c.RetrieveSSHKeyContext(ctx, fingerprint)
+
+	if err == nil {
+		return sshKey, nil
+	}
+
+	if !IsNotFound(err) {
+		return SSHKey{}, err
+	}
+
+	return c.createSSHKey(ctx, opts)
+}
+
+// RetrieveSSHKey gets an SSH key by the ID or fingerprint specified and
+// returns a SSHKey and an error. An error will be returned for failed
+// requests with a nil SSHKey.
 func (c *Client) RetrieveSSHKey(id string) (SSHKey, error) {
-	req, err := c.NewRequest(nil, "GET", fmt.Sprintf("/account/keys/%s", id))
+	return c.RetrieveSSHKeyContext(context.Background(), id)
+}
+
+// RetrieveSSHKeyContext is the context-aware form of RetrieveSSHKey.
+func (c *Client) RetrieveSSHKeyContext(ctx context.Context, id string) (SSHKey, error) {
+	req, err := c.NewRequestContext(ctx, nil, "GET", fmt.Sprintf("/account/keys/%s", id))
 
 	if err != nil {
 		return SSHKey{}, err
 	}
 
-	resp, err := checkResp(c.Http.Do(req))
+	resp, err := checkResp(c.do(req))
 	if err != nil {
-		return SSHKey{}, fmt.Errorf("Error retreiving SSH key: %s", err)
+		return SSHKey{}, fmt.Errorf("Error retreiving SSH key: %w", err)
 	}
 
 	sshKey := new(sshKeyResponse)
@@ -75,50 +132,91 @@ func (c *Client) RetrieveSSHKey(id string) (SSHKey, error) {
 	err = decodeBody(resp, &sshKey)
 
 	if err != nil {
-		return SSHKey{}, fmt.Errorf("Error decoding droplet response: %s", err)
+		return SSHKey{}, fmt.Errorf("Error decoding droplet response: %w", err)
 	}
 
 	// The request was successful
 	return sshKey.SSHKey, nil
 }
 
-// RenameSSHKey renames an SSH key to the name specified
+// RenameSSHKey renames an SSH key, by ID or fingerprint, to the name
+// specified
 func (c *Client) RenameSSHKey(id string, name string) error {
+	return c.RenameSSHKeyContext(context.Background(), id, name)
+}
+
+// RenameSSHKeyContext is the context-aware form of RenameSSHKey.
+func (c *Client) RenameSSHKeyContext(ctx context.Context, id string, name string) error {
 	params := &CreateSSHKey{
 		Name: name,
 	}
 
-	req, err := c.NewRequest(params, "PUT", fmt.Sprintf("/account/keys/%s", id))
+	req, err := c.NewRequestContext(ctx, params, "PUT", fmt.Sprintf("/account/keys/%s", id))
 
 	if err != nil {
 		return err
 	}
 
-	_, err = checkResp(c.Http.Do(req))
+	_, err = checkResp(c.do(req))
 
 	if err != nil {
-		return fmt.Errorf("Error updating record: %s", err)
+		return fmt.Errorf("Error updating record: %w", err)
 	}
 
 	// The request was successful
 	return nil
 }
 
-// DestroySSHKey destroys an SSH key by the ID specified and returns an error if
-// it fails. If no error is returned, the key was succesfully destroyed.
+// DestroySSHKey destroys an SSH key by the ID or fingerprint specified and
+// returns an error if it fails. If no error is returned, the key was
+// succesfully destroyed.
 func (c *Client) DestroySSHKey(id string) error {
-	req, err := c.NewRequest(nil, "DELETE", fmt.Sprintf("/account/keys/%s", id))
+	return c.DestroySSHKeyContext(context.Background(), id)
+}
+
+// DestroySSHKeyContext is the context-aware form of DestroySSHKey.
+func (c *Client) DestroySSHKeyContext(ctx context.Context, id string) error {
+	req, err := c.NewRequestContext(ctx, nil, "DELETE", fmt.Sprintf("/account/keys/%s", id))
 
 	if err != nil {
 		return err
 	}
 
-	_, err = checkResp(c.Http.Do(req))
+	_, err = checkResp(c.do(req))
 
 	if err != nil {
-		return fmt.Errorf("Error destroying SSH key: %s", err)
+		return fmt.Errorf("Error destroying SSH key: %w", err)
 	}
 
 	// The request was successful
 	return nil
 }
+
+// RetrieveSSHKeys gets the list of all SSH keys registered on the
+// account, following every page, and an error. An error will be
+// returned for failed requests with a nil slice.
+func (c *Client) RetrieveSSHKeys() ([]SSHKey, error) {
+	return c.RetrieveSSHKeysContext(context.Background())
+}
+
+// RetrieveSSHKeysContext is the context-aware form of RetrieveSSHKeys.
+func (c *Client) RetrieveSSHKeysContext(ctx context.Context) ([]SSHKey, error) {
+	keys, err := collect(c.IterateSSHKeysContext(ctx))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving SSH keys: %w", err)
+	}
+
+	// The request was successful
+	return keys, nil
+}
+
+// IterateSSHKeys returns an Iterator over all SSH keys.
+func (c *Client) IterateSSHKeys() *Iterator[SSHKey] {
+	return c.IterateSSHKeysContext(context.Background())
+}
+
+// IterateSSHKeysContext is the context-aware form of IterateSSHKeys.
+func (c *Client) IterateSSHKeysContext(ctx context.Context) *Iterator[SSHKey] {
+	return newIterator[SSHKey](ctx, c, "/account/keys", "ssh_keys")
+}
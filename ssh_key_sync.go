@@ -0,0 +1,207 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// SSHKeyRename is a change of the name of a registered SSH key
+type SSHKeyRename struct {
+	Key  SSHKey
+	Name string
+}
+
+// SSHKeyPlan is the set of changes that converge the SSH keys of the
+// account to those of an authorized_keys file
+type SSHKeyPlan struct {
+	Create []CreateSSHKey
+	Rename []SSHKeyRename
+	Delete []SSHKey
+}
+
+// Empty returns whether the account already has the desired keys
+func (p *SSHKeyPlan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Rename) == 0 && len(p.Delete) == 0
+}
+
+// SSHKeySyncResult is the outcome of a sync: the plan computed, and
+// the steps of it that were applied. Both are the same once a sync
+// succeeds.
+type SSHKeySyncResult struct {
+	Plan    SSHKeyPlan
+	Applied SSHKeyPlan
+}
+
+// SyncSSHKeys contains the parameters of an SSH key sync
+type SyncSSHKeys struct {
+	Prune  bool // true to delete the keys missing from the file
+	DryRun bool // true to only compute the plan, without changing anything
+}
+
+// isKeyType returns whether the field is a supported key type
+func isKeyType(field string) bool {
+	return field == "ssh-rsa" || field == "ssh-ed25519" || strings.HasPrefix(field, "ecdsa-sha2-")
+}
+
+// ParseAuthorizedKeys parses the keys of an OpenSSH authorized_keys
+// file. Blank lines and comments starting with # are skipped, and
+// any options before the key type are ignored.
+func ParseAuthorizedKeys(data string) ([]*PublicKey, error) {
+	var keys []*PublicKey
+
+	for n, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if fields := strings.Fields(line); !isKeyType(fields[0]) {
+			line = skipKeyOptions(line)
+		}
+
+		key, err := ParsePublicKey(line)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing authorized keys: line %d: %w", n+1, err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// skipKeyOptions returns the line without its leading options, such
+// as command="echo hi",no-pty. Options end at the first whitespace
+// outside of quotes.
+func skipKeyOptions(line string) string {
+	quoted := false
+
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case ' ', '\t':
+			if !quoted {
+				return strings.TrimSpace(line[i:])
+			}
+		}
+	}
+
+	return ""
+}
+
+// PlanSSHKeys computes the changes that turn the registered SSH keys
+// into the keys specified, matching them by fingerprint. Keys are named
+// after their comment. New keys without one are named after their
+// fingerprint, and registered keys without one keep their name. Keys
+// missing from the list are only deleted if prune is set.
+func PlanSSHKeys(existing []SSHKey, keys []*PublicKey, prune bool) SSHKeyPlan {
+	plan := SSHKeyPlan{}
+
+	registered := make(map[string]SSHKey)
+	for _, k := range existing {
+		registered[strings.ToLower(k.Fingerprint)] = k
+	}
+
+	wanted := make(map[string]bool)
+
+	for _, k := range keys {
+		fingerprint := k.Fingerprint()
+
+		// The first of any duplicate keys wins
+		if wanted[fingerprint] {
+			continue
+		}
+		wanted[fingerprint] = true
+
+		current, ok := registered[fingerprint]
+
+		switch {
+		case !ok:
+			name := k.Comment
+			if name == "" {
+				name = fingerprint
+			}
+			plan.Create = append(plan.Create, CreateSSHKey{Name: name, PublicKey: k.String()})
+		case k.Comment != "" && current.Name != k.Comment:
+			plan.Rename = append(plan.Rename, SSHKeyRename{current, k.Comment})
+		}
+	}
+
+	if prune {
+		for _, k := range existing {
+			if !wanted[strings.ToLower(k.Fingerprint)] {
+				plan.Delete = append(plan.Delete, k)
+			}
+		}
+	}
+
+	return plan
+}
+
+// SyncSSHKeys converges the SSH keys of the account to the keys of
+// the authorized_keys file specified, following the plan computed by
+// PlanSSHKeys. Keys are created and renamed first, then pruned. With
+// DryRun set, only the plan is computed.
+//
+// A failed sync leaves the account partly converged. The result holds
+// the steps applied so far, so the caller can retry or roll back.
+func (c *Client) SyncSSHKeys(authorizedKeys string, opts *SyncSSHKeys) (SSHKeySyncResult, error) {
+	return c.SyncSSHKeysContext(context.Background(), authorizedKeys, opts)
+}
+
+// SyncSSHKeysContext is the context-aware form of SyncSSHKeys.
+func (c *Client) SyncSSHKeysContext(ctx context.Context, authorizedKeys string, opts *SyncSSHKeys) (SSHKeySyncResult, error) {
+	if opts == nil {
+		opts = &SyncSSHKeys{}
+	}
+
+	keys, err := ParseAuthorizedKeys(authorizedKeys)
+
+	if err != nil {
+		return SSHKeySyncResult{}, err
+	}
+
+	existing, err := c.RetrieveSSHKeysContext(ctx)
+
+	if err != nil {
+		return SSHKeySyncResult{}, fmt.Errorf("Error syncing SSH keys: %w", err)
+	}
+
+	result := SSHKeySyncResult{Plan: PlanSSHKeys(existing, keys, opts.Prune)}
+
+	if opts.DryRun {
+		return result, nil
+	}
+
+	plan := &result.Plan
+	applied := &result.Applied
+
+	for i := range plan.Create {
+		if _, err := c.createSSHKey(ctx, &plan.Create[i]); err != nil {
+			return result, fmt.Errorf("Error syncing SSH keys: %w", err)
+		}
+		applied.Create = append(applied.Create, plan.Create[i])
+	}
+
+	for _, r := range plan.Rename {
+		if err := c.RenameSSHKeyContext(ctx, r.Key.StringId(), r.Name); err != nil {
+			return result, fmt.Errorf("Error syncing SSH keys: %w", err)
+		}
+		applied.Rename = append(applied.Rename, r)
+	}
+
+	for _, k := range plan.Delete {
+		if err := c.DestroySSHKeyContext(ctx, k.StringId()); err != nil {
+			return result, fmt.Errorf("Error syncing SSH keys: %w", err)
+		}
+		applied.Delete = append(applied.Delete, k)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,171 @@
+package digitalocean
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestSSHKeySync(t *testing.T) {
+	TestingT(t)
+}
+
+func (s *S) Test_SyncSSHKeys(c *C) {
+	testServer.Response(200, nil, sshKeysSyncExample)
+	testServer.Response(201, nil, sshKeyExample)
+	testServer.Response(201, nil, sshKeyExample)
+	testServer.Response(200, nil, sshKeyExample)
+	testServer.Response(204, nil, "")
+
+	result, err := s.client.SyncSSHKeys(authorizedKeysExample, &SyncSSHKeys{Prune: true})
+
+	reqs := testServer.WaitRequests(5)
+
+	c.Assert(err, IsNil)
+	c.Assert(result.Plan.Create, HasLen, 2)
+	c.Assert(result.Plan.Rename, HasLen, 1)
+	c.Assert(result.Plan.Delete, HasLen, 1)
+	c.Assert(result.Applied, DeepEquals, result.Plan)
+
+	c.Assert(reqs[0].URL.Path, Equals, "/account/keys")
+
+	c.Assert(reqs[1].Method, Equals, "POST")
+	var create map[string]string
+	c.Assert(json.NewDecoder(reqs[1].Body).Decode(&create), IsNil)
+	c.Assert(create["name"], Equals, "bob@laptop")
+	c.Assert(create["public_key"], Equals, publicKeyEd25519)
+
+	c.Assert(reqs[2].Method, Equals, "POST")
+
+	c.Assert(reqs[3].Method, Equals, "PUT")
+	c.Assert(reqs[3].URL.Path, Equals, "/account/keys/512189")
+	var rename map[string]string
+	c.Assert(json.NewDecoder(reqs[3].Body).Decode(&rename), IsNil)
+	c.Assert(rename["name"], Equals, "alice@example.com")
+
+	c.Assert(reqs[4].Method, Equals, "DELETE")
+	c.Assert(reqs[4].URL.Path, Equals, "/account/keys/512190")
+}
+
+func (s *S) Test_SyncSSHKeys_DryRun(c *C) {
+	testServer.Response(200, nil, sshKeysSyncExample)
+
+	result, err := s.client.SyncSSHKeys(authorizedKeysExample, &SyncSSHKeys{DryRun: true})
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(result.Applied.Empty(), Equals, true)
+
+	plan := result.Plan
+	c.Assert(plan.Create, HasLen, 2)
+	c.Assert(plan.Rename, DeepEquals, []SSHKeyRename{{plan.Rename[0].Key, "alice@example.com"}})
+	c.Assert(plan.Rename[0].Key.Id, Equals, int64(512189))
+	c.Assert(plan.Delete, HasLen, 0)
+}
+
+func (s *S) Test_SyncSSHKeys_Failed(c *C) {
+	testServer.Response(200, nil, sshKeysSyncExample)
+	testServer.Response(201, nil, sshKeyExample)
+	testServer.Response(500, nil, "")
+
+	result, err := s.client.SyncSSHKeys(authorizedKeysExample, &SyncSSHKeys{Prune: true})
+
+	_ = testServer.WaitRequests(3)
+
+	c.Assert(err, ErrorMatches, "Error syncing SSH keys: .*500.*")
+	c.Assert(result.Plan.Create, HasLen, 2)
+	c.Assert(result.Applied.Create, DeepEquals, result.Plan.Create[:1])
+	c.Assert(result.Applied.Rename, HasLen, 0)
+	c.Assert(result.Applied.Delete, HasLen, 0)
+}
+
+func TestParseAuthorizedKeys(t *testing.T) {
+	keys, err := ParseAuthorizedKeys(authorizedKeysExample)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if len(keys) != 3 {
+		t.Fatalf("bad keys: %#v", keys)
+	}
+
+	if keys[1].Type != "ssh-ed25519" || keys[1].Comment != "bob@laptop" {
+		t.Fatalf("bad key with options: %#v", keys[1])
+	}
+
+	if keys[2].Type != "ecdsa-sha2-nistp256" || keys[2].Comment != "" {
+		t.Fatalf("bad key without comment: %#v", keys[2])
+	}
+
+	_, err = ParseAuthorizedKeys("# team keys\n\nssh-rsa abcd\n")
+	if err == nil || err.Error() != "Error parsing authorized keys: line 3: Error parsing SSH public key: truncated key" {
+		t.Fatalf("bad error: %v", err)
+	}
+}
+
+func TestPlanSSHKeys(t *testing.T) {
+	keys, err := ParseAuthorizedKeys(authorizedKeysExample)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	existing := []SSHKey{
+		{Id: 1, Name: "alice@example.com", Fingerprint: "F1:C1:1E:26:16:79:64:96:84:70:98:C3:2F:EC:28:C6"},
+		{Id: 2, Name: "bob@laptop", Fingerprint: "90:9a:be:ff:f4:1b:54:22:a0:f8:72:49:9e:ad:05:b0"},
+		{Id: 3, Name: "gone", Fingerprint: "00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00"},
+	}
+
+	plan := PlanSSHKeys(existing, append(keys, keys[0]), false)
+
+	if len(plan.Rename) != 0 || len(plan.Delete) != 0 || len(plan.Create) != 1 {
+		t.Fatalf("bad plan: %#v", plan)
+	}
+
+	// Keys without a comment are named after their fingerprint
+	if plan.Create[0].Name != "49:15:8f:ce:ab:a6:75:ed:3d:72:66:f2:ff:1a:63:60" {
+		t.Fatalf("bad name: %s", plan.Create[0].Name)
+	}
+
+	plan = PlanSSHKeys(existing, keys, true)
+
+	if len(plan.Delete) != 1 || plan.Delete[0].Id != 3 {
+		t.Fatalf("bad plan: %#v", plan)
+	}
+
+	// Registered keys keep their name when the file has no comment
+	existing = append(existing, SSHKey{Id: 4, Name: "carol", Fingerprint: "49:15:8f:ce:ab:a6:75:ed:3d:72:66:f2:ff:1a:63:60"})
+
+	if plan = PlanSSHKeys(existing, keys, false); !plan.Empty() {
+		t.Fatalf("bad plan: %#v", plan)
+	}
+}
+
+var authorizedKeysExample = `# Team keys
+` + publicKeyRSA + `
+
+command="echo \"hi there\"",no-pty ` + publicKeyEd25519 + `
+ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBHU2hWPu53TrBdQuYadCjzk66GdQJpL07XJ0YDtFPtyESxo6agbVWzpNeqgiu4VFLyAkeWLwe5jWpMxNYEHQowE=
+`
+
+var sshKeysSyncExample = `{
+  "ssh_keys": [
+    {
+      "id": 512189,
+      "fingerprint": "f1:c1:1e:26:16:79:64:96:84:70:98:c3:2f:ec:28:c6",
+      "public_key": "` + publicKeyRSA + `",
+      "name": "alice"
+    },
+    {
+      "id": 512190,
+      "fingerprint": "3b:16:bf:e4:8b:00:8b:b8:59:8c:a9:d3:f0:19:45:fa",
+      "public_key": "ssh-rsa AEXAMPLE old",
+      "name": "old"
+    }
+  ],
+  "links": {},
+  "meta": {
+    "total": 2
+  }
+}`
@@ -15,7 +15,7 @@ func (s *S) Test_CreateSSHKey(c *C) {
 
 	opts := CreateSSHKey{
 		Name:      "A",
-		PublicKey: "abcd",
+		PublicKey: publicKeyEd25519,
 	}
 
 	id, err := s.client.CreateSSHKey(&opts)
@@ -26,6 +26,71 @@ func (s *S) Test_CreateSSHKey(c *C) {
 	c.Assert(id, Equals, "16")
 }
 
+func (s *S) Test_CreateSSHKey_Invalid(c *C) {
+	opts := CreateSSHKey{
+		Name:      "A",
+		PublicKey: "abcd",
+	}
+
+	_, err := s.client.CreateSSHKey(&opts)
+
+	c.Assert(err, ErrorMatches, "Error parsing SSH public key: .*")
+}
+
+func (s *S) Test_EnsureSSHKey_Existing(c *C) {
+	testServer.Response(200, nil, sshKeyExample)
+
+	opts := CreateSSHKey{
+		Name:      "A",
+		PublicKey: publicKeyEd25519,
+	}
+
+	sshKey, err := s.client.EnsureSSHKey(&opts)
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.Method, Equals, "GET")
+	c.Assert(req.URL.Path, Equals, "/account/keys/90:9a:be:ff:f4:1b:54:22:a0:f8:72:49:9e:ad:05:b0")
+	c.Assert(sshKey.StringId(), Equals, "16")
+}
+
+func (s *S) Test_EnsureSSHKey_Missing(c *C) {
+	testServer.Response(404, nil, `{"id": "not_found", "message": "The resource you were accessing could not be found."}`)
+	testServer.Response(201, nil, sshKeyExample)
+
+	opts := CreateSSHKey{
+		Name:      "A",
+		PublicKey: publicKeyEd25519,
+	}
+
+	sshKey, err := s.client.EnsureSSHKey(&opts)
+
+	reqs := testServer.WaitRequests(2)
+
+	c.Assert(err, IsNil)
+	c.Assert(reqs[1].Method, Equals, "POST")
+	c.Assert(reqs[1].URL.Path, Equals, "/account/keys")
+	c.Assert(sshKey.StringId(), Equals, "16")
+	c.Assert(sshKey.Name, Equals, "A")
+}
+
+func (s *S) Test_EnsureSSHKey_Error(c *C) {
+	testServer.Response(500, nil, "")
+
+	opts := CreateSSHKey{
+		Name:      "A",
+		PublicKey: publicKeyEd25519,
+	}
+
+	_, err := s.client.EnsureSSHKey(&opts)
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, NotNil)
+	c.Assert(IsNotFound(err), Equals, false)
+}
+
 func (s *S) Test_RetrieveSSHKey(c *C) {
 	testServer.Response(200, nil, sshKeyExample)
 
@@ -50,6 +115,56 @@ func (s *S) Test_RenameSSHKey(c *C) {
 	c.Assert(err, IsNil)
 }
 
+func (s *S) Test_RetrieveSSHKey_Fingerprint(c *C) {
+	testServer.Response(200, nil, sshKeyExample)
+
+	_, err := s.client.RetrieveSSHKey("3b:16:bf:e4:8b:00:8b:b8:59:8c:a9:d3:f0:19:45:fa")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/account/keys/3b:16:bf:e4:8b:00:8b:b8:59:8c:a9:d3:f0:19:45:fa")
+}
+
+func (s *S) Test_RenameSSHKey_Fingerprint(c *C) {
+	testServer.Response(200, nil, sshKeyExample)
+
+	err := s.client.RenameSSHKey("3b:16:bf:e4:8b:00:8b:b8:59:8c:a9:d3:f0:19:45:fa", "pepe")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.Method, Equals, "PUT")
+	c.Assert(req.URL.Path, Equals, "/account/keys/3b:16:bf:e4:8b:00:8b:b8:59:8c:a9:d3:f0:19:45:fa")
+}
+
+func (s *S) Test_DestroySSHKey_Fingerprint(c *C) {
+	testServer.Response(204, nil, "")
+
+	err := s.client.DestroySSHKey("3b:16:bf:e4:8b:00:8b:b8:59:8c:a9:d3:f0:19:45:fa")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.Method, Equals, "DELETE")
+	c.Assert(req.URL.Path, Equals, "/account/keys/3b:16:bf:e4:8b:00:8b:b8:59:8c:a9:d3:f0:19:45:fa")
+}
+
+func (s *S) Test_RetrieveSSHKeys(c *C) {
+	testServer.Response(200, nil, sshKeysExample)
+
+	keys, err := s.client.RetrieveSSHKeys()
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/account/keys")
+	c.Assert(keys, HasLen, 2)
+	c.Assert(keys[0].StringId(), Equals, "512189")
+	c.Assert(keys[0].Fingerprint, Equals, "3b:16:bf:e4:8b:00:8b:b8:59:8c:a9:d3:f0:19:45:fa")
+	c.Assert(keys[1].Name, Equals, "deploy")
+}
+
 var sshKeyExample = `{
   "ssh_key": {
     "id": 16,
@@ -58,3 +173,24 @@ var sshKeyExample = `{
     "public_key": "abcd"
   }
 }`
+
+var sshKeysExample = `{
+  "ssh_keys": [
+    {
+      "id": 512189,
+      "fingerprint": "3b:16:bf:e4:8b:00:8b:b8:59:8c:a9:d3:f0:19:45:fa",
+      "public_key": "ssh-rsa AEXAMPLEaC1yc2EAAAADAQABAAAAQQDDHr/jh2Jy4yALcK4JyWbVkPRaWmhck3IgCoeOO3z1e2dBowLh64QAM+Qb72pxekALga2oi4GvT+TlWNhzPH4V example",
+      "name": "My SSH Public Key"
+    },
+    {
+      "id": 512190,
+      "fingerprint": "f5:d1:78:ed:28:72:5f:e1:ac:94:fd:1f:e0:a3:48:6d",
+      "public_key": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIExample deploy",
+      "name": "deploy"
+    }
+  ],
+  "links": {},
+  "meta": {
+    "total": 2
+  }
+}`
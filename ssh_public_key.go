@@ -0,0 +1,161 @@
+package digitalocean
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// PublicKey is a parsed OpenSSH public key, as found in an
+// authorized_keys or .pub file
+type PublicKey struct {
+	Type    string // Type of the key, such as ssh-rsa
+	Blob    []byte // Blob is the key in the SSH wire format
+	Comment string // Comment following the key, often user@host
+}
+
+// ecdsaPointSizes are the sizes of the uncompressed public point of
+// each supported ECDSA curve
+var ecdsaPointSizes = map[string]int{
+	"nistp256": 65,
+	"nistp384": 97,
+	"nistp521": 133,
+}
+
+// ParsePublicKey parses an OpenSSH public key of the form
+// "type base64 [comment]". RSA, Ed25519 and ECDSA keys are supported,
+// and the key type embedded in the blob must match the declared one.
+func ParsePublicKey(key string) (*PublicKey, error) {
+	fields := strings.Fields(key)
+
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("Error parsing SSH public key: expected a type and a key")
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing SSH public key: bad base64 key: %w", err)
+	}
+
+	if err := checkPublicKey(fields[0], blob); err != nil {
+		return nil, fmt.Errorf("Error parsing SSH public key: %w", err)
+	}
+
+	return &PublicKey{
+		Type:    fields[0],
+		Blob:    blob,
+		Comment: strings.Join(fields[2:], " "),
+	}, nil
+}
+
+// checkPublicKey checks the wire format blob is a key of the type
+// specified
+func checkPublicKey(keyType string, blob []byte) error {
+	r := &wireReader{blob}
+
+	embedded, ok := r.next()
+	if !ok {
+		return fmt.Errorf("truncated key")
+	}
+
+	if string(embedded) != keyType {
+		return fmt.Errorf("key type %s does not match declared type %s", embedded, keyType)
+	}
+
+	switch {
+	case keyType == "ssh-rsa":
+		// The public exponent and the modulus
+		e, ok1 := r.next()
+		n, ok2 := r.next()
+		if !ok1 || !ok2 || len(e) == 0 || len(n) == 0 {
+			return fmt.Errorf("truncated RSA key")
+		}
+	case keyType == "ssh-ed25519":
+		if point, ok := r.next(); !ok || len(point) != 32 {
+			return fmt.Errorf("bad Ed25519 key")
+		}
+	case strings.HasPrefix(keyType, "ecdsa-sha2-"):
+		curve := strings.TrimPrefix(keyType, "ecdsa-sha2-")
+		size, supported := ecdsaPointSizes[curve]
+		if !supported {
+			return fmt.Errorf("unsupported ECDSA curve %s", curve)
+		}
+
+		name, ok := r.next()
+		if !ok || string(name) != curve {
+			return fmt.Errorf("ECDSA curve %s does not match declared curve %s", name, curve)
+		}
+
+		if point, ok := r.next(); !ok || len(point) != size || point[0] != 4 {
+			return fmt.Errorf("bad ECDSA %s key", curve)
+		}
+	default:
+		return fmt.Errorf("unsupported key type %s", keyType)
+	}
+
+	if len(r.data) > 0 {
+		return fmt.Errorf("trailing data after key")
+	}
+
+	return nil
+}
+
+// wireReader reads the length-prefixed strings of the SSH wire format
+type wireReader struct {
+	data []byte
+}
+
+// next returns the next string, or false if the data is too short
+func (r *wireReader) next() ([]byte, bool) {
+	if len(r.data) < 4 {
+		return nil, false
+	}
+
+	n := binary.BigEndian.Uint32(r.data)
+	if uint64(n) > uint64(len(r.data)-4) {
+		return nil, false
+	}
+
+	s := r.data[4 : 4+n]
+	r.data = r.data[4+n:]
+
+	return s, true
+}
+
+// Fingerprint returns the MD5 fingerprint of the key, as colon
+// separated hex, the form DigitalOcean identifies keys by
+func (k *PublicKey) Fingerprint() string {
+	sum := md5.Sum(k.Blob)
+
+	hex := make([]string, len(sum))
+	for i, b := range sum {
+		hex[i] = fmt.Sprintf("%02x", b)
+	}
+
+	return strings.Join(hex, ":")
+}
+
+// String returns the key in the authorized_keys format
+func (k *PublicKey) String() string {
+	s := k.Type + " " + base64.StdEncoding.EncodeToString(k.Blob)
+
+	if k.Comment != "" {
+		s += " " + k.Comment
+	}
+
+	return s
+}
+
+// Fingerprint returns the MD5 fingerprint of an OpenSSH public key,
+// or an error if it can't be parsed. See ParsePublicKey.
+func Fingerprint(key string) (string, error) {
+	k, err := ParsePublicKey(key)
+
+	if err != nil {
+		return "", err
+	}
+
+	return k.Fingerprint(), nil
+}
@@ -0,0 +1,100 @@
+package digitalocean
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func TestParsePublicKey(t *testing.T) {
+	cases := []struct {
+		key         string
+		keyType     string
+		comment     string
+		fingerprint string
+	}{
+		{publicKeyRSA, "ssh-rsa", "alice@example.com", "f1:c1:1e:26:16:79:64:96:84:70:98:c3:2f:ec:28:c6"},
+		{publicKeyEd25519, "ssh-ed25519", "bob@laptop", "90:9a:be:ff:f4:1b:54:22:a0:f8:72:49:9e:ad:05:b0"},
+		{publicKeyECDSA, "ecdsa-sha2-nistp256", "carol", "49:15:8f:ce:ab:a6:75:ed:3d:72:66:f2:ff:1a:63:60"},
+	}
+
+	for _, tc := range cases {
+		k, err := ParsePublicKey(tc.key)
+		if err != nil {
+			t.Fatalf("err: %v", err)
+		}
+
+		if k.Type != tc.keyType {
+			t.Fatalf("bad type: %s", k.Type)
+		}
+
+		if k.Comment != tc.comment {
+			t.Fatalf("bad comment: %s", k.Comment)
+		}
+
+		if k.Fingerprint() != tc.fingerprint {
+			t.Fatalf("bad fingerprint: %s", k.Fingerprint())
+		}
+
+		if k.String() != tc.key {
+			t.Fatalf("bad string: %s", k.String())
+		}
+	}
+}
+
+func TestParsePublicKey_errors(t *testing.T) {
+	ed25519Blob := strings.Fields(publicKeyEd25519)[1]
+	rsaBlob := strings.Fields(publicKeyRSA)[1]
+
+	// An Ed25519 key with a short point
+	blob := wireString("ssh-ed25519")
+	blob = append(blob, wireString("short")...)
+	short := base64.StdEncoding.EncodeToString(blob)
+
+	cases := map[string]string{
+		"":                                  "expected a type and a key",
+		"ssh-rsa":                           "expected a type and a key",
+		"ssh-rsa !!!":                       "bad base64 key",
+		"ssh-rsa " + ed25519Blob:            "key type ssh-ed25519 does not match declared type ssh-rsa",
+		"ssh-dss " + rsaBlob:                "key type ssh-rsa does not match declared type ssh-dss",
+		"ssh-ed25519 " + short:              "bad Ed25519 key",
+		"ssh-ed25519 " + ed25519Blob + "AA": "bad base64 key",
+		"ssh-rsa AAAAB3NzaC1yc2E=":          "truncated RSA key",
+	}
+
+	for key, expected := range cases {
+		_, err := ParsePublicKey(key)
+		if err == nil {
+			t.Fatalf("expected an error for %q", key)
+		}
+
+		if !strings.HasPrefix(err.Error(), "Error parsing SSH public key: "+expected) {
+			t.Fatalf("bad error for %q: %s", key, err)
+		}
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	fingerprint, err := Fingerprint(publicKeyECDSA)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if fingerprint != "49:15:8f:ce:ab:a6:75:ed:3d:72:66:f2:ff:1a:63:60" {
+		t.Fatalf("bad fingerprint: %s", fingerprint)
+	}
+}
+
+// wireString encodes a string in the SSH wire format
+func wireString(s string) []byte {
+	b := make([]byte, 4, 4+len(s))
+	binary.BigEndian.PutUint32(b, uint32(len(s)))
+	return append(b, s...)
+}
+
+var publicKeyRSA = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAAAgQDONGlCQG3QbD/PB6vlY/33DFvUjnZrN0FH+ycbkA7Zz32b+EW4S7VVmYaF3w6vxU24RQuSqgYMQlYMmyMo+hzwdc14onqKVGwhc6did+2+iPBoHGYOZUdrzMwFIGqRCbEzMUwkvc/0o4HysLLirW/8Ior/n04Ze9UhKJVGFXAmLQ== alice@example.com"
+
+var publicKeyEd25519 = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAILYnR7Fc26Oo7zyOClFX2sJVegz4FI9Te1WRQEZ3dwOQ bob@laptop"
+
+var publicKeyECDSA = "ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBHU2hWPu53TrBdQuYadCjzk66GdQJpL07XJ0YDtFPtyESxo6agbVWzpNeqgiu4VFLyAkeWLwe5jWpMxNYEHQowE= carol"
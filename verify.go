@@ -1,6 +1,7 @@
 package digitalocean
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -8,16 +9,15 @@ import (
 // the provided authentication is working and returns
 // an error if it is not.
 func (c *Client) VerifyAuthentication() error {
-	req, err := c.NewRequest(map[string]string{}, "GET", "/droplets")
-
-	if err != nil {
-		return err
-	}
+	return c.VerifyAuthenticationContext(context.Background())
+}
 
-	resp, err := checkResp(c.Http.Do(req))
+// VerifyAuthenticationContext is the context-aware form of VerifyAuthentication.
+func (c *Client) VerifyAuthenticationContext(ctx context.Context) error {
+	_, err := c.RetrieveAccountContext(ctx)
 
 	if err != nil {
-		return fmt.Errorf("Error verfiying authentication: %s", parseErr(resp))
+		return fmt.Errorf("Error verfiying authentication: %w", err)
 	}
 
 	// The request was successful
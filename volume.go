@@ -0,0 +1,309 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type VolumeResponse struct {
+	Volume Volume `json:"volume"`
+}
+
+// Volume is used to represent a retrieved block storage volume.
+type Volume struct {
+	Id             string    `json:"id"`
+	Region         Region    `json:"region"`
+	DropletIds     []int64   `json:"droplet_ids"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	SizeGigabytes  int64     `json:"size_gigabytes"`
+	FilesystemType string    `json:"filesystem_type"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// CreateVolume contains the request parameters to create a new
+// volume.
+type CreateVolume struct {
+	Name           string `json:"name,omitempty"`            // Name of the volume
+	Region         string `json:"region,omitempty"`          // Slug of the region to create the volume in
+	SizeGigabytes  int64  `json:"size_gigabytes,omitempty"`  // Size of the volume in gigabytes
+	Description    string `json:"description,omitempty"`     // Free form description of the volume
+	SnapshotId     string `json:"snapshot_id,omitempty"`     // ID of a volume snapshot to create the volume from
+	FilesystemType string `json:"filesystem_type,omitempty"` // "ext4" or "xfs" to format the volume
+}
+
+// CreateVolume creates a volume from the parameters specified and
+// returns an error if it fails. If no error and an ID is returned,
+// the Volume was succesfully created.
+func (c *Client) CreateVolume(opts *CreateVolume) (string, error) {
+	return c.CreateVolumeContext(context.Background(), opts)
+}
+
+// CreateVolumeContext is the context-aware form of CreateVolume.
+func (c *Client) CreateVolumeContext(ctx context.Context, opts *CreateVolume) (string, error) {
+	req, err := c.NewRequestContext(ctx, opts, "POST", "/volumes")
+
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := checkResp(c.do(req))
+
+	if err != nil {
+		return "", fmt.Errorf("Error creating volume: %w", err)
+	}
+
+	volume := new(VolumeResponse)
+
+	err = decodeBody(resp, &volume)
+
+	if err != nil {
+		return "", fmt.Errorf("Error parsing volume response: %w", err)
+	}
+
+	// The request was successful
+	return volume.Volume.Id, nil
+}
+
+// ListVolumes contains the parameters to filter the list of volumes.
+// The zero value lists every volume.
+type ListVolumes struct {
+	Name   string // Only list the volumes with this name
+	Region string // Only list the volumes in this region
+}
+
+// endpoint returns the list endpoint matching the filters
+func (opts *ListVolumes) endpoint() string {
+	endpoint := "/volumes"
+
+	if opts == nil {
+		return endpoint
+	}
+
+	if opts.Name != "" {
+		endpoint = addQuery(endpoint, "name", opts.Name)
+	}
+
+	if opts.Region != "" {
+		endpoint = addQuery(endpoint, "region", opts.Region)
+	}
+
+	return endpoint
+}
+
+// RetrieveVolumes gets the list of volumes matching the filters,
+// following every page, and an error. An error will be returned for
+// failed requests with a nil slice.
+func (c *Client) RetrieveVolumes(opts *ListVolumes) ([]Volume, error) {
+	return c.RetrieveVolumesContext(context.Background(), opts)
+}
+
+// RetrieveVolumesContext is the context-aware form of RetrieveVolumes.
+func (c *Client) RetrieveVolumesContext(ctx context.Context, opts *ListVolumes) ([]Volume, error) {
+	volumes, err := collect(c.IterateVolumesContext(ctx, opts))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving volumes: %w", err)
+	}
+
+	// The request was successful
+	return volumes, nil
+}
+
+// IterateVolumes returns an Iterator over the volumes matching
+// the filters.
+func (c *Client) IterateVolumes(opts *ListVolumes) *Iterator[Volume] {
+	return c.IterateVolumesContext(context.Background(), opts)
+}
+
+// IterateVolumesContext is the context-aware form of IterateVolumes.
+func (c *Client) IterateVolumesContext(ctx context.Context, opts *ListVolumes) *Iterator[Volume] {
+	return newIterator[Volume](ctx, c, opts.endpoint(), "volumes")
+}
+
+// RetrieveVolume gets a volume by the ID specified and returns
+// a Volume and an error. An error will be returned for failed
+// requests with a nil Volume.
+func (c *Client) RetrieveVolume(id string) (Volume, error) {
+	return c.RetrieveVolumeContext(context.Background(), id)
+}
+
+// RetrieveVolumeContext is the context-aware form of RetrieveVolume.
+func (c *Client) RetrieveVolumeContext(ctx context.Context, id string) (Volume, error) {
+	req, err := c.NewRequestContext(ctx, nil, "GET", fmt.Sprintf("/volumes/%s", id))
+
+	if err != nil {
+		return Volume{}, err
+	}
+
+	resp, err := checkResp(c.do(req))
+	if err != nil {
+		return Volume{}, fmt.Errorf("Error retrieving volume: %w", err)
+	}
+
+	volume := new(VolumeResponse)
+
+	err = decodeBody(resp, volume)
+
+	if err != nil {
+		return Volume{}, fmt.Errorf("Error decoding volume response: %w", err)
+	}
+
+	// The request was successful
+	return volume.Volume, nil
+}
+
+// DestroyVolume destroys a volume by the ID specified and
+// returns an error if it fails. If no error is returned,
+// the Volume was succesfully destroyed.
+func (c *Client) DestroyVolume(id string) error {
+	return c.DestroyVolumeContext(context.Background(), id)
+}
+
+// DestroyVolumeContext is the context-aware form of DestroyVolume.
+func (c *Client) DestroyVolumeContext(ctx context.Context, id string) error {
+	req, err := c.NewRequestContext(ctx, nil, "DELETE", fmt.Sprintf("/volumes/%s", id))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = checkResp(c.do(req))
+
+	if err != nil {
+		return fmt.Errorf("Error destroying volume: %w", err)
+	}
+
+	// The request was successful
+	return nil
+}
+
+// VolumeAction sends the specified action to the volume and returns
+// the resulting Action. An error is retunred, and is nil if successful
+func (c *Client) VolumeAction(id string, action map[string]interface{}) (Action, error) {
+	return c.VolumeActionContext(context.Background(), id, action)
+}
+
+// VolumeActionContext is the context-aware form of VolumeAction.
+func (c *Client) VolumeActionContext(ctx context.Context, id string, action map[string]interface{}) (Action, error) {
+	req, err := c.NewRequestContext(ctx, action, "POST", fmt.Sprintf("/volumes/%s/actions", id))
+
+	if err != nil {
+		return Action{}, err
+	}
+
+	resp, err := checkResp(c.do(req))
+	if err != nil {
+		return Action{}, fmt.Errorf("Error processing volume action: %w", err)
+	}
+
+	return decodeAction(resp)
+}
+
+// Attaches the volume to the droplet ID specified
+func (c *Client) AttachVolume(id string, dropletId string) (Action, error) {
+	return c.AttachVolumeContext(context.Background(), id, dropletId)
+}
+
+// AttachVolumeContext is the context-aware form of AttachVolume.
+func (c *Client) AttachVolumeContext(ctx context.Context, id string, dropletId string) (Action, error) {
+	return c.VolumeActionContext(ctx, id, map[string]interface{}{
+		"type":       "attach",
+		"droplet_id": idParam(dropletId),
+	})
+}
+
+// Detaches the volume from the droplet ID specified
+func (c *Client) DetachVolume(id string, dropletId string) (Action, error) {
+	return c.DetachVolumeContext(context.Background(), id, dropletId)
+}
+
+// DetachVolumeContext is the context-aware form of DetachVolume.
+func (c *Client) DetachVolumeContext(ctx context.Context, id string, dropletId string) (Action, error) {
+	return c.VolumeActionContext(ctx, id, map[string]interface{}{
+		"type":       "detach",
+		"droplet_id": idParam(dropletId),
+	})
+}
+
+// Resizes the volume to the size in gigabytes specified. Volumes
+// can only grow.
+func (c *Client) ResizeVolume(id string, sizeGigabytes int64) (Action, error) {
+	return c.ResizeVolumeContext(context.Background(), id, sizeGigabytes)
+}
+
+// ResizeVolumeContext is the context-aware form of ResizeVolume.
+func (c *Client) ResizeVolumeContext(ctx context.Context, id string, sizeGigabytes int64) (Action, error) {
+	return c.VolumeActionContext(ctx, id, map[string]interface{}{
+		"type":           "resize",
+		"size_gigabytes": sizeGigabytes,
+	})
+}
+
+// CreateVolumeSnapshot takes a snapshot of the volume with the name
+// specified and returns the Snapshot and an error. An error will be
+// returned for failed requests with a nil Snapshot.
+func (c *Client) CreateVolumeSnapshot(id string, name string) (Snapshot, error) {
+	return c.CreateVolumeSnapshotContext(context.Background(), id, name)
+}
+
+// CreateVolumeSnapshotContext is the context-aware form of CreateVolumeSnapshot.
+func (c *Client) CreateVolumeSnapshotContext(ctx context.Context, id string, name string) (Snapshot, error) {
+	params := map[string]string{
+		"name": name,
+	}
+
+	req, err := c.NewRequestContext(ctx, params, "POST", fmt.Sprintf("/volumes/%s/snapshots", id))
+
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	resp, err := checkResp(c.do(req))
+
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("Error creating volume snapshot: %w", err)
+	}
+
+	snapshot := new(SnapshotResponse)
+
+	err = decodeBody(resp, &snapshot)
+
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("Error parsing snapshot response: %w", err)
+	}
+
+	// The request was successful
+	return snapshot.Snapshot, nil
+}
+
+// RetrieveVolumeSnapshots gets the list of snapshots of the volume by
+// the ID specified, following every page, and an error. An error will
+// be returned for failed requests with a nil slice.
+func (c *Client) RetrieveVolumeSnapshots(id string) ([]Snapshot, error) {
+	return c.RetrieveVolumeSnapshotsContext(context.Background(), id)
+}
+
+// RetrieveVolumeSnapshotsContext is the context-aware form of RetrieveVolumeSnapshots.
+func (c *Client) RetrieveVolumeSnapshotsContext(ctx context.Context, id string) ([]Snapshot, error) {
+	snapshots, err := collect(c.IterateVolumeSnapshotsContext(ctx, id))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving volume snapshots: %w", err)
+	}
+
+	// The request was successful
+	return snapshots, nil
+}
+
+// IterateVolumeSnapshots returns an Iterator over the snapshots of
+// the volume by the ID specified.
+func (c *Client) IterateVolumeSnapshots(id string) *Iterator[Snapshot] {
+	return c.IterateVolumeSnapshotsContext(context.Background(), id)
+}
+
+// IterateVolumeSnapshotsContext is the context-aware form of IterateVolumeSnapshots.
+func (c *Client) IterateVolumeSnapshotsContext(ctx context.Context, id string) *Iterator[Snapshot] {
+	return newIterator[Snapshot](ctx, c, fmt.Sprintf("/volumes/%s/snapshots", id), "snapshots")
+}
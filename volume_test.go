@@ -0,0 +1,183 @@
+package digitalocean
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestVolume(t *testing.T) {
+	TestingT(t)
+}
+
+func (s *S) Test_CreateVolume(c *C) {
+	testServer.Response(201, nil, volumeExample)
+
+	opts := CreateVolume{
+		Name:          "example",
+		Region:        "nyc1",
+		SizeGigabytes: 10,
+		Description:   "Block store for examples",
+	}
+
+	id, err := s.client.CreateVolume(&opts)
+
+	req := testServer.WaitRequest()
+
+	body, _ := ioutil.ReadAll(req.Body)
+
+	c.Assert(err, IsNil)
+	c.Assert(id, Equals, "506f78a4-e098-11e5-ad9f-000f53306ae1")
+	c.Assert(strings.TrimSpace(string(body)), Equals, `{"name":"example","region":"nyc1","size_gigabytes":10,"description":"Block store for examples"}`)
+}
+
+func (s *S) Test_RetrieveVolumes(c *C) {
+	testServer.Response(200, nil, volumesExample)
+
+	volumes, err := s.client.RetrieveVolumes(&ListVolumes{Name: "example", Region: "nyc1"})
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/volumes")
+	c.Assert(req.URL.Query().Get("name"), Equals, "example")
+	c.Assert(req.URL.Query().Get("region"), Equals, "nyc1")
+	c.Assert(volumes, HasLen, 1)
+	c.Assert(volumes[0].DropletIds, DeepEquals, []int64{25})
+}
+
+func (s *S) Test_RetrieveVolume(c *C) {
+	testServer.Response(200, nil, volumeExample)
+
+	volume, err := s.client.RetrieveVolume("506f78a4-e098-11e5-ad9f-000f53306ae1")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/volumes/506f78a4-e098-11e5-ad9f-000f53306ae1")
+	c.Assert(volume.Name, Equals, "example")
+	c.Assert(volume.Region.Slug, Equals, "nyc1")
+	c.Assert(volume.SizeGigabytes, Equals, int64(10))
+	c.Assert(volume.FilesystemType, Equals, "ext4")
+	c.Assert(volume.DropletIds, HasLen, 0)
+}
+
+func (s *S) Test_DestroyVolume(c *C) {
+	testServer.Response(204, nil, "")
+
+	err := s.client.DestroyVolume("506f78a4-e098-11e5-ad9f-000f53306ae1")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.Method, Equals, "DELETE")
+}
+
+func (s *S) Test_VolumeActions(c *C) {
+	cases := []struct {
+		action func() (Action, error)
+		body   string
+	}{
+		{func() (Action, error) { return s.client.AttachVolume("7724db7c", "25") }, `{"droplet_id":25,"type":"attach"}`},
+		{func() (Action, error) { return s.client.DetachVolume("7724db7c", "25") }, `{"droplet_id":25,"type":"detach"}`},
+		{func() (Action, error) { return s.client.ResizeVolume("7724db7c", 100) }, `{"size_gigabytes":100,"type":"resize"}`},
+	}
+
+	for _, tc := range cases {
+		testServer.Response(202, nil, dropletExampleAction)
+
+		action, err := tc.action()
+
+		req := testServer.WaitRequest()
+
+		body, _ := ioutil.ReadAll(req.Body)
+
+		c.Assert(err, IsNil)
+		c.Assert(action.StringId(), Equals, "15")
+		c.Assert(req.URL.Path, Equals, "/volumes/7724db7c/actions")
+		c.Assert(strings.TrimSpace(string(body)), Equals, tc.body)
+	}
+}
+
+func (s *S) Test_CreateVolumeSnapshot(c *C) {
+	testServer.Response(201, nil, volumeSnapshotExample)
+
+	snapshot, err := s.client.CreateVolumeSnapshot("7724db7c", "big-data-snapshot1475261774")
+
+	req := testServer.WaitRequest()
+
+	body, _ := ioutil.ReadAll(req.Body)
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/volumes/7724db7c/snapshots")
+	c.Assert(strings.TrimSpace(string(body)), Equals, `{"name":"big-data-snapshot1475261774"}`)
+	c.Assert(snapshot.Id, Equals, "8fa70202-873f-11e6-8b68-000f533176b1")
+	c.Assert(snapshot.ResourceType, Equals, "volume")
+	c.Assert(snapshot.SizeGigabytes, Equals, 0.0)
+}
+
+func (s *S) Test_RetrieveVolumeSnapshots(c *C) {
+	testServer.Response(200, nil, `{"snapshots": [`+volumeSnapshotJSON+`], "links": {}, "meta": {"total": 1}}`)
+
+	snapshots, err := s.client.RetrieveVolumeSnapshots("7724db7c")
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.URL.Path, Equals, "/volumes/7724db7c/snapshots")
+	c.Assert(snapshots, HasLen, 1)
+	c.Assert(snapshots[0].Regions, DeepEquals, []string{"nyc1"})
+}
+
+var volumeExample = `{
+  "volume": {
+    "id": "506f78a4-e098-11e5-ad9f-000f53306ae1",
+    "region": {
+      "name": "New York 1",
+      "slug": "nyc1",
+      "available": true
+    },
+    "droplet_ids": [],
+    "name": "example",
+    "description": "Block store for examples",
+    "size_gigabytes": 10,
+    "filesystem_type": "ext4",
+    "created_at": "2016-03-02T17:00:49Z"
+  }
+}`
+
+var volumesExample = `{
+  "volumes": [
+    {
+      "id": "506f78a4-e098-11e5-ad9f-000f53306ae1",
+      "region": {
+        "name": "New York 1",
+        "slug": "nyc1"
+      },
+      "droplet_ids": [25],
+      "name": "example",
+      "description": "Block store for examples",
+      "size_gigabytes": 10,
+      "created_at": "2016-03-02T17:00:49Z"
+    }
+  ],
+  "links": {},
+  "meta": {
+    "total": 1
+  }
+}`
+
+var volumeSnapshotJSON = `{
+    "id": "8fa70202-873f-11e6-8b68-000f533176b1",
+    "name": "big-data-snapshot1475261774",
+    "regions": ["nyc1"],
+    "created_at": "2016-09-30T18:56:12Z",
+    "resource_id": "82a48a18-873f-11e6-96bf-000f53315a41",
+    "resource_type": "volume",
+    "min_disk_size": 10,
+    "size_gigabytes": 0
+}`
+
+var volumeSnapshotExample = `{"snapshot": ` + volumeSnapshotJSON + `}`
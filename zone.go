@@ -0,0 +1,457 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// zoneToken is a word of a zone file entry. Quoted tokens keep
+// their content without the quotes.
+type zoneToken struct {
+	text   string
+	quoted bool
+}
+
+// zoneEntry is a logical line of a zone file, with any parentheses
+// already joined
+type zoneEntry struct {
+	line     int
+	indented bool
+	tokens   []zoneToken
+}
+
+// hostnameTypes are the record types whose data is a domain name
+var hostnameTypes = map[string]bool{
+	"CNAME": true,
+	"MX":    true,
+	"NS":    true,
+	"SRV":   true,
+}
+
+// ParseZoneFile parses a BIND zone file, such as Domain.ZoneFile, into
+// records of the zone origin specified. Names are made relative to the
+// origin, with @ for the origin itself, the way the API returns them.
+// Domain names in record data are made fully qualified, ending with a
+// dot, and TXT strings are joined. $ORIGIN and $TTL are supported.
+func ParseZoneFile(origin string, zone string) ([]Record, error) {
+	origin = fqdn(origin)
+
+	entries, err := splitZone(zone)
+	if err != nil {
+		return nil, err
+	}
+
+	current := origin
+	defaultTTL := 0
+	previous := ""
+	records := []Record{}
+
+	for _, e := range entries {
+		tokens := e.tokens
+
+		switch strings.ToUpper(tokens[0].text) {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("Error parsing zone file: line %d: $ORIGIN takes a single name", e.line)
+			}
+			current = absoluteName(tokens[1].text, current)
+			continue
+		case "$TTL":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("Error parsing zone file: line %d: $TTL takes a single value", e.line)
+			}
+			if defaultTTL, err = parseTTL(tokens[1].text); err != nil {
+				return nil, fmt.Errorf("Error parsing zone file: line %d: %w", e.line, err)
+			}
+			continue
+		}
+
+		if strings.HasPrefix(tokens[0].text, "$") && !tokens[0].quoted {
+			return nil, fmt.Errorf("Error parsing zone file: line %d: unsupported directive %s", e.line, tokens[0].text)
+		}
+
+		name := previous
+		if !e.indented {
+			name = absoluteName(tokens[0].text, current)
+			tokens = tokens[1:]
+		}
+
+		if name == "" {
+			return nil, fmt.Errorf("Error parsing zone file: line %d: record has no name", e.line)
+		}
+		previous = name
+
+		record := Record{
+			Name: relativeName(name, origin),
+			TTL:  defaultTTL,
+		}
+
+		// The TTL and class come in either order before the type
+		for len(tokens) > 0 {
+			t := tokens[0].text
+
+			if ttl, err := parseTTL(t); err == nil {
+				record.TTL = ttl
+			} else if up := strings.ToUpper(t); up == "IN" || up == "CH" || up == "HS" {
+				// Only the class is checked, it doesn't matter to the API
+			} else {
+				break
+			}
+
+			tokens = tokens[1:]
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("Error parsing zone file: line %d: record has no type", e.line)
+		}
+
+		record.Type = strings.ToUpper(tokens[0].text)
+
+		if err := parseRData(&record, tokens[1:], current); err != nil {
+			return nil, fmt.Errorf("Error parsing zone file: line %d: %w", e.line, err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// parseRData fills the data fields of the record from its tokens
+func parseRData(r *Record, tokens []zoneToken, origin string) error {
+	want := map[string]int{
+		"A":     1,
+		"AAAA":  1,
+		"CNAME": 1,
+		"NS":    1,
+		"MX":    2,
+		"SRV":   4,
+		"CAA":   3,
+		"SOA":   7,
+	}
+
+	if n, ok := want[r.Type]; ok && len(tokens) != n {
+		return fmt.Errorf("%s record needs %d fields, got %d", r.Type, n, len(tokens))
+	}
+
+	if len(tokens) == 0 {
+		return fmt.Errorf("%s record has no data", r.Type)
+	}
+
+	numbers := func(fields ...*int) error {
+		for i, f := range fields {
+			v, err := strconv.Atoi(tokens[i].text)
+			if err != nil {
+				return fmt.Errorf("%s record has a bad number %q", r.Type, tokens[i].text)
+			}
+			*f = v
+		}
+		return nil
+	}
+
+	var err error
+
+	switch r.Type {
+	case "MX":
+		err = numbers(&r.Priority)
+	case "SRV":
+		err = numbers(&r.Priority, &r.Weight, &r.Port)
+	case "CAA":
+		err = numbers(&r.Flags)
+		r.Tag = tokens[1].text
+		r.Data = tokens[2].text
+	case "TXT":
+		var data []string
+		for _, t := range tokens {
+			data = append(data, t.text)
+		}
+		r.Data = strings.Join(data, "")
+	case "SOA":
+		r.Data = strings.Join([]string{
+			absoluteName(tokens[0].text, origin),
+			absoluteName(tokens[1].text, origin),
+			tokens[2].text, tokens[3].text, tokens[4].text, tokens[5].text, tokens[6].text,
+		}, " ")
+	default:
+		var data []string
+		for _, t := range tokens {
+			data = append(data, t.text)
+		}
+		r.Data = strings.Join(data, " ")
+	}
+
+	if hostnameTypes[r.Type] {
+		r.Data = absoluteName(tokens[len(tokens)-1].text, origin)
+	}
+
+	return err
+}
+
+// splitZone breaks a zone file into entries, dropping comments and
+// blank lines and joining entries that span lines in parentheses
+func splitZone(zone string) ([]zoneEntry, error) {
+	var entries []zoneEntry
+	var entry *zoneEntry
+	depth := 0
+
+	for n, line := range strings.Split(zone, "\n") {
+		if depth == 0 {
+			entry = &zoneEntry{
+				line:     n + 1,
+				indented: len(line) > 0 && (line[0] == ' ' || line[0] == '\t'),
+			}
+		}
+
+		for i := 0; i < len(line); i++ {
+			switch ch := line[i]; ch {
+			case ';':
+				i = len(line)
+			case ' ', '\t', '\r':
+			case '(':
+				depth++
+			case ')':
+				if depth == 0 {
+					return nil, fmt.Errorf("Error parsing zone file: line %d: unbalanced parentheses", n+1)
+				}
+				depth--
+			case '"':
+				var text []byte
+				closed := false
+				for i++; i < len(line); i++ {
+					if line[i] == '\\' && i+1 < len(line) {
+						i++
+						text = append(text, line[i])
+					} else if line[i] == '"' {
+						closed = true
+						break
+					} else {
+						text = append(text, line[i])
+					}
+				}
+				if !closed {
+					return nil, fmt.Errorf("Error parsing zone file: line %d: unterminated string", n+1)
+				}
+				entry.tokens = append(entry.tokens, zoneToken{string(text), true})
+			default:
+				start := i
+				for i < len(line) && !strings.ContainsRune(" \t\r;()\"", rune(line[i])) {
+					i++
+				}
+				entry.tokens = append(entry.tokens, zoneToken{line[start:i], false})
+				i--
+			}
+		}
+
+		if depth == 0 && len(entry.tokens) > 0 {
+			entries = append(entries, *entry)
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("Error parsing zone file: unbalanced parentheses")
+	}
+
+	return entries, nil
+}
+
+// parseTTL parses a TTL in seconds, or with BIND units like 1h30m
+func parseTTL(s string) (int, error) {
+	if ttl, err := strconv.Atoi(s); err == nil && ttl >= 0 {
+		return ttl, nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, n, digits := 0, 0, false
+
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case ch >= '0' && ch <= '9':
+			n = n*10 + int(ch-'0')
+			digits = true
+		case digits && units[ch|0x20] > 0:
+			total += n * units[ch|0x20]
+			n, digits = 0, false
+		default:
+			return 0, fmt.Errorf("bad TTL %q", s)
+		}
+	}
+
+	if s == "" || digits {
+		return 0, fmt.Errorf("bad TTL %q", s)
+	}
+
+	return total, nil
+}
+
+// fqdn returns the name with a trailing dot
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "."
+}
+
+// absoluteName resolves a name of a zone file against the origin
+func absoluteName(name string, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+// relativeName returns the name relative to the origin, @ for the
+// origin itself, or the fully qualified name if it is outside of it
+func relativeName(name string, origin string) string {
+	lname, lorigin := strings.ToLower(name), strings.ToLower(origin)
+
+	switch {
+	case lname == lorigin:
+		return "@"
+	case strings.HasSuffix(lname, "."+lorigin):
+		return name[:len(name)-len(origin)-1]
+	default:
+		return name
+	}
+}
+
+// RenderZoneFile renders the records of the zone origin specified into
+// a BIND zone file. Record names and data are written as the API returns
+// them: names relative to the origin and data relative unless it ends
+// with a dot. A $TTL line is added if ttl is positive, and records with
+// a TTL of their own keep it.
+func RenderZoneFile(origin string, ttl int, records []Record) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "$ORIGIN %s\n", fqdn(origin))
+	if ttl > 0 {
+		fmt.Fprintf(&b, "$TTL %d\n", ttl)
+	}
+
+	for _, r := range records {
+		name := r.Name
+		if name == "" {
+			name = "@"
+		}
+
+		b.WriteString(name)
+		if r.TTL > 0 {
+			fmt.Fprintf(&b, "\t%d", r.TTL)
+		}
+		fmt.Fprintf(&b, "\tIN\t%s\t%s\n", r.Type, renderRData(r))
+	}
+
+	return b.String()
+}
+
+// renderRData renders the data fields of a record
+func renderRData(r Record) string {
+	switch r.Type {
+	case "MX":
+		return fmt.Sprintf("%d %s", r.Priority, r.Data)
+	case "SRV":
+		return fmt.Sprintf("%d %d %d %s", r.Priority, r.Weight, r.Port, r.Data)
+	case "CAA":
+		return fmt.Sprintf("%d %s %s", r.Flags, r.Tag, quoteZoneString(r.Data))
+	case "TXT":
+		// Strings are limited to 255 characters, so split longer data
+		var strs []string
+		data := r.Data
+		for len(data) > 255 {
+			strs = append(strs, quoteZoneString(data[:255]))
+			data = data[255:]
+		}
+		strs = append(strs, quoteZoneString(data))
+		return strings.Join(strs, " ")
+	default:
+		return r.Data
+	}
+}
+
+// quoteZoneString quotes a zone file string, escaping quotes
+// and backslashes
+func quoteZoneString(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	return `"` + s + `"`
+}
+
+// Records parses the zone file of the domain into its records,
+// with ParseZoneFile
+func (d *Domain) Records() ([]Record, error) {
+	return ParseZoneFile(d.Name, d.ZoneFile)
+}
+
+// ExportZoneFile renders the current records of the domain specified
+// into a BIND zone file, for backups or diffs. The TTL of the domain
+// is used as the $TTL of the zone.
+func (c *Client) ExportZoneFile(domain string) (string, error) {
+	return c.ExportZoneFileContext(context.Background(), domain)
+}
+
+// ExportZoneFileContext is the context-aware form of ExportZoneFile.
+func (c *Client) ExportZoneFileContext(ctx context.Context, domain string) (string, error) {
+	d, err := c.RetrieveDomainContext(ctx, domain)
+
+	if err != nil {
+		return "", fmt.Errorf("Error exporting zone file: %w", err)
+	}
+
+	// The API only gives the TTL of the SOA record, so take the
+	// full record from the domain's own zone file
+	parsed, err := d.Records()
+
+	if err != nil {
+		return "", fmt.Errorf("Error exporting zone file: %w", err)
+	}
+
+	var zone []Record
+
+	for _, r := range parsed {
+		if r.Type == "SOA" {
+			zone = append(zone, r)
+		}
+	}
+
+	if len(zone) == 0 {
+		return "", fmt.Errorf("Error exporting zone file: the zone file of %s has no SOA record", domain)
+	}
+
+	records, err := c.RetrieveRecordsContext(ctx, domain, nil)
+
+	if err != nil {
+		return "", fmt.Errorf("Error exporting zone file: %w", err)
+	}
+
+	for _, r := range records {
+		if r.Type != "SOA" {
+			r.Data = apiHostname(r, domain)
+			zone = append(zone, r)
+		}
+	}
+
+	return RenderZoneFile(domain, d.TTL, zone), nil
+}
+
+// apiHostname returns the data of a record from the API in the
+// form ParseZoneFile gives it. The API returns domain names fully
+// qualified but may leave out the trailing dot, or use @ for the
+// domain itself.
+func apiHostname(r Record, domain string) string {
+	if !hostnameTypes[r.Type] || r.Data == "" {
+		return r.Data
+	}
+
+	if r.Data == "@" {
+		return fqdn(domain)
+	}
+
+	return fqdn(r.Data)
+}
@@ -0,0 +1,323 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RecordUpdate is a change of an existing record into the desired one
+type RecordUpdate struct {
+	Existing Record
+	Desired  Record
+}
+
+// ZonePlan is the set of changes that converge the records of a
+// domain to the desired ones
+type ZonePlan struct {
+	Create []Record
+	Update []RecordUpdate
+	Delete []Record
+}
+
+// Empty returns whether the domain already has the desired records
+func (p *ZonePlan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Update) == 0 && len(p.Delete) == 0
+}
+
+// ImportResult is the outcome of an import: the plan computed, and
+// the steps of it that were applied. Both are the same once an import
+// succeeds.
+type ImportResult struct {
+	Plan    ZonePlan
+	Applied ZonePlan
+}
+
+// ImportZone contains the parameters of a zone import
+type ImportZone struct {
+	DryRun bool // true to only compute the plan, without changing anything
+}
+
+// importTypes are the record types the API supports
+var importTypes = map[string]bool{
+	"A":     true,
+	"AAAA":  true,
+	"CAA":   true,
+	"CNAME": true,
+	"MX":    true,
+	"NS":    true,
+	"SOA":   true,
+	"SRV":   true,
+	"TXT":   true,
+}
+
+// managedRecord returns whether the record is managed by DigitalOcean
+// itself, and so left alone by imports: the SOA and the apex NS records
+func managedRecord(r Record) bool {
+	return r.Type == "SOA" || (r.Type == "NS" && r.Name == "@")
+}
+
+// recordKey identifies a record by its type, name and data. Only
+// domain names are case insensitive, other data such as TXT values
+// is compared as it is.
+func recordKey(r Record, domain string) string {
+	data := apiHostname(r, domain)
+	if hostnameTypes[r.Type] {
+		data = strings.ToLower(data)
+	}
+
+	key := r.Type + " " + strings.ToLower(r.Name) + " " + data
+
+	if r.Type == "CAA" {
+		key += " " + r.Tag
+	}
+
+	return key
+}
+
+// sameFields returns whether the desired record needs no change to
+// the fields of the existing one that aren't part of its key. A desired
+// TTL of zero leaves the existing TTL alone.
+func sameFields(existing Record, desired Record) bool {
+	return existing.Priority == desired.Priority &&
+		existing.Port == desired.Port &&
+		existing.Weight == desired.Weight &&
+		existing.Flags == desired.Flags &&
+		(desired.TTL == 0 || existing.TTL == desired.TTL)
+}
+
+// PlanZone computes the changes that turn the existing records of the
+// domain into the desired ones. Records matching on type, name and data
+// are kept, or updated if their other fields differ. Remaining records
+// of the same type and name are paired up and updated, and the rest are
+// created or deleted. SOA and apex NS records are managed by
+// DigitalOcean, so they are left out.
+func PlanZone(domain string, existing []Record, desired []Record) ZonePlan {
+	plan := ZonePlan{}
+
+	// Index the existing records by key, keeping their order
+	byKey := make(map[string][]int)
+	for i, r := range existing {
+		if !managedRecord(r) {
+			k := recordKey(r, domain)
+			byKey[k] = append(byKey[k], i)
+		}
+	}
+
+	matched := make(map[int]bool)
+	var unmatched []Record
+
+	for _, d := range desired {
+		if managedRecord(d) {
+			continue
+		}
+
+		k := recordKey(d, domain)
+		if idx := byKey[k]; len(idx) > 0 {
+			if r := existing[idx[0]]; !sameFields(r, d) {
+				plan.Update = append(plan.Update, RecordUpdate{r, d})
+			}
+			matched[idx[0]] = true
+			byKey[k] = idx[1:]
+			continue
+		}
+
+		unmatched = append(unmatched, d)
+	}
+
+	// Pair the remaining records of the same type and name, so they
+	// are updated in place rather than replaced
+	for _, d := range unmatched {
+		paired := false
+
+		for i, r := range existing {
+			if matched[i] || managedRecord(r) {
+				continue
+			}
+
+			if r.Type == d.Type && strings.EqualFold(r.Name, d.Name) {
+				plan.Update = append(plan.Update, RecordUpdate{r, d})
+				matched[i] = true
+				paired = true
+				break
+			}
+		}
+
+		if !paired {
+			plan.Create = append(plan.Create, d)
+		}
+	}
+
+	for i, r := range existing {
+		if !matched[i] && !managedRecord(r) {
+			plan.Delete = append(plan.Delete, r)
+		}
+	}
+
+	return plan
+}
+
+// recordOpts returns the parameters to create the record
+func recordOpts(r Record) *CreateRecord {
+	opts := &CreateRecord{
+		Type: r.Type,
+		Name: r.Name,
+		Data: r.Data,
+	}
+
+	switch r.Type {
+	case "MX":
+		opts.Priority = strconv.Itoa(r.Priority)
+	case "SRV":
+		opts.Priority = strconv.Itoa(r.Priority)
+		opts.Port = strconv.Itoa(r.Port)
+		opts.Weight = strconv.Itoa(r.Weight)
+	case "CAA":
+		opts.Flags = strconv.Itoa(r.Flags)
+		opts.Tag = r.Tag
+	}
+
+	if r.TTL > 0 {
+		opts.TTL = strconv.Itoa(r.TTL)
+	}
+
+	return opts
+}
+
+// ImportZoneFile converges the records of the domain specified to
+// those of a BIND zone file, as parsed by ParseZoneFile. See
+// ImportRecords.
+func (c *Client) ImportZoneFile(domain string, zone string, opts *ImportZone) (ImportResult, error) {
+	return c.ImportZoneFileContext(context.Background(), domain, zone, opts)
+}
+
+// ImportZoneFileContext is the context-aware form of ImportZoneFile.
+func (c *Client) ImportZoneFileContext(ctx context.Context, domain string, zone string, opts *ImportZone) (ImportResult, error) {
+	records, err := ParseZoneFile(domain, zone)
+
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	return c.ImportRecordsContext(ctx, domain, records, opts)
+}
+
+// ImportRecords converges the records of the domain specified to the
+// desired ones, following the plan computed by PlanZone. Records are
+// updated and created before the old ones are deleted, except records
+// a new CNAME can't coexist with, which are deleted first. With DryRun
+// set, only the plan is computed. Invalid records, records of a type
+// the API doesn't support and records named outside of the domain are
+// refused before any request is made.
+//
+// A failed import leaves the domain partly converged. The result holds
+// the steps applied so far, with the Id of any created record, so the
+// caller can retry or roll back.
+func (c *Client) ImportRecords(domain string, records []Record, opts *ImportZone) (ImportResult, error) {
+	return c.ImportRecordsContext(context.Background(), domain, records, opts)
+}
+
+// ImportRecordsContext is the context-aware form of ImportRecords.
+func (c *Client) ImportRecordsContext(ctx context.Context, domain string, records []Record, opts *ImportZone) (ImportResult, error) {
+	if opts == nil {
+		opts = &ImportZone{}
+	}
+
+	// Catch invalid records before anything is changed. Names are
+	// made relative to the domain, like the API returns them.
+	records = append([]Record(nil), records...)
+
+	for i := range records {
+		r := &records[i]
+		r.Type = strings.ToUpper(r.Type)
+		r.Name = relativeName(r.Name, fqdn(domain))
+
+		if !importTypes[r.Type] {
+			return ImportResult{}, fmt.Errorf("Error importing records: %s %s: unsupported record type", r.Type, r.Name)
+		}
+
+		if strings.HasSuffix(r.Name, ".") {
+			return ImportResult{}, fmt.Errorf("Error importing records: %s %s: name is outside of %s", r.Type, r.Name, domain)
+		}
+
+		if managedRecord(*r) {
+			continue
+		}
+
+		if err := recordOpts(*r).Validate(); err != nil {
+			return ImportResult{}, fmt.Errorf("Error importing records: %s %s: %w", r.Type, r.Name, err)
+		}
+	}
+
+	existing, err := c.RetrieveRecordsContext(ctx, domain, nil)
+
+	if err != nil {
+		return ImportResult{}, fmt.Errorf("Error importing records: %w", err)
+	}
+
+	result := ImportResult{Plan: PlanZone(domain, existing, records)}
+
+	if opts.DryRun {
+		return result, nil
+	}
+
+	plan := &result.Plan
+	applied := &result.Applied
+
+	// Records sharing a name with a new CNAME have to go first
+	var later []Record
+	for _, r := range plan.Delete {
+		if !cnameConflict(r, plan.Create) {
+			later = append(later, r)
+			continue
+		}
+
+		if err := c.DestroyRecordContext(ctx, domain, r.StringId()); err != nil {
+			return result, fmt.Errorf("Error importing records: %w", err)
+		}
+		applied.Delete = append(applied.Delete, r)
+	}
+
+	for _, u := range plan.Update {
+		update := UpdateRecord(*recordOpts(u.Desired))
+
+		if err := c.UpdateRecordContext(ctx, domain, u.Existing.StringId(), &update); err != nil {
+			return result, fmt.Errorf("Error importing records: %w", err)
+		}
+		applied.Update = append(applied.Update, u)
+	}
+
+	for _, r := range plan.Create {
+		id, err := c.CreateRecordContext(ctx, domain, recordOpts(r))
+
+		if err != nil {
+			return result, fmt.Errorf("Error importing records: %w", err)
+		}
+
+		r.Id, _ = strconv.Atoi(id)
+		applied.Create = append(applied.Create, r)
+	}
+
+	for _, r := range later {
+		if err := c.DestroyRecordContext(ctx, domain, r.StringId()); err != nil {
+			return result, fmt.Errorf("Error importing records: %w", err)
+		}
+		applied.Delete = append(applied.Delete, r)
+	}
+
+	return result, nil
+}
+
+// cnameConflict returns whether the record has to be deleted before
+// the records specified can be created: a CNAME can't share its name
+// with any other record
+func cnameConflict(r Record, creates []Record) bool {
+	for _, c := range creates {
+		if strings.EqualFold(r.Name, c.Name) && (r.Type == "CNAME" || c.Type == "CNAME") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,256 @@
+package digitalocean
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestZoneImport(t *testing.T) {
+	TestingT(t)
+}
+
+func (s *S) Test_ImportZoneFile_DryRun(c *C) {
+	testServer.Response(200, nil, recordsExample)
+
+	zone := `$ORIGIN example.com.
+$TTL 1800
+@	IN	SOA	ns1.digitalocean.com. hostmaster.example.com. 1 10800 3600 604800 1800
+@	IN	NS	ns1.digitalocean.com.
+@	IN	MX	10 mx1.example.com.
+@	IN	MX	30 mx3.example.com.
+www	IN	A	1.2.3.4
+`
+
+	result, err := s.client.ImportZoneFile("example.com", zone, &ImportZone{DryRun: true})
+
+	req := testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(req.Method, Equals, "GET")
+	c.Assert(req.URL.Path, Equals, "/domains/example.com/records")
+
+	plan := result.Plan
+	c.Assert(plan.Update, HasLen, 1)
+	c.Assert(plan.Update[0].Existing.Id, Equals, 28448433)
+	c.Assert(plan.Update[0].Desired.Data, Equals, "mx3.example.com.")
+	c.Assert(plan.Update[0].Desired.Priority, Equals, 30)
+	c.Assert(plan.Create, DeepEquals, []Record{{Type: "A", Name: "www", TTL: 1800, Data: "1.2.3.4"}})
+	c.Assert(plan.Delete, HasLen, 0)
+	c.Assert(result.Applied.Empty(), Equals, true)
+}
+
+func (s *S) Test_ImportZoneFile_LongTXT(c *C) {
+	testServer.Response(200, nil, recordsExample)
+	testServer.Response(201, nil, recordExample)
+	testServer.Response(204, nil, "")
+	testServer.Response(204, nil, "")
+
+	long := strings.Repeat("a", 250)
+	zone := "$ORIGIN example.com.\n" +
+		"dkim._domainkey 1800 IN TXT ( \"" + long + "\"\n\t\"" + long + "\" )\n"
+
+	result, err := s.client.ImportZoneFile("example.com", zone, nil)
+
+	reqs := testServer.WaitRequests(4)
+
+	c.Assert(err, IsNil)
+	c.Assert(result.Applied.Create, HasLen, 1)
+	c.Assert(result.Applied.Delete, HasLen, 2)
+
+	c.Assert(reqs[1].Method, Equals, "POST")
+	var create map[string]interface{}
+	c.Assert(json.NewDecoder(reqs[1].Body).Decode(&create), IsNil)
+	c.Assert(create["type"], Equals, "TXT")
+	c.Assert(create["name"], Equals, "dkim._domainkey")
+	c.Assert(create["data"], Equals, long+long)
+
+	c.Assert(reqs[2].Method, Equals, "DELETE")
+	c.Assert(reqs[3].Method, Equals, "DELETE")
+}
+
+func (s *S) Test_ImportRecords(c *C) {
+	testServer.Response(200, nil, recordsExample)
+	testServer.Response(200, nil, recordExample)
+	testServer.Response(201, nil, recordExample)
+	testServer.Response(204, nil, "")
+
+	records := []Record{
+		{Type: "MX", Name: "@", Priority: 10, TTL: 3600, Data: "mx1.example.com."},
+		{Type: "A", Name: "www", Data: "1.2.3.4"},
+	}
+
+	result, err := s.client.ImportRecords("example.com", records, nil)
+
+	reqs := testServer.WaitRequests(4)
+
+	c.Assert(err, IsNil)
+	c.Assert(result.Plan.Delete, HasLen, 1)
+	c.Assert(result.Plan.Update, HasLen, 1)
+	c.Assert(result.Plan.Create, HasLen, 1)
+	c.Assert(result.Applied.Update, DeepEquals, result.Plan.Update)
+	c.Assert(result.Applied.Delete, DeepEquals, result.Plan.Delete)
+	c.Assert(result.Applied.Create, HasLen, 1)
+	c.Assert(result.Applied.Create[0].Id, Equals, 16)
+
+	// Old records are only deleted once the new ones are in place
+	c.Assert(reqs[1].Method, Equals, "PUT")
+	c.Assert(reqs[1].URL.Path, Equals, "/domains/example.com/records/28448432")
+	var update map[string]interface{}
+	c.Assert(json.NewDecoder(reqs[1].Body).Decode(&update), IsNil)
+	c.Assert(update["ttl"], Equals, "3600")
+	c.Assert(update["data"], Equals, "mx1.example.com.")
+
+	c.Assert(reqs[2].Method, Equals, "POST")
+	c.Assert(reqs[2].URL.Path, Equals, "/domains/example.com/records")
+	var create map[string]interface{}
+	c.Assert(json.NewDecoder(reqs[2].Body).Decode(&create), IsNil)
+	c.Assert(create["type"], Equals, "A")
+	c.Assert(create["name"], Equals, "www")
+	c.Assert(create["data"], Equals, "1.2.3.4")
+
+	c.Assert(reqs[3].Method, Equals, "DELETE")
+	c.Assert(reqs[3].URL.Path, Equals, "/domains/example.com/records/28448433")
+}
+
+func (s *S) Test_ImportRecords_Failed(c *C) {
+	testServer.Response(200, nil, recordsExample)
+	testServer.Response(200, nil, recordExample)
+	testServer.Response(500, nil, "")
+
+	records := []Record{
+		{Type: "MX", Name: "@", Priority: 10, TTL: 3600, Data: "mx1.example.com."},
+		{Type: "A", Name: "www", Data: "1.2.3.4"},
+	}
+
+	result, err := s.client.ImportRecords("example.com", records, nil)
+
+	_ = testServer.WaitRequests(3)
+
+	c.Assert(err, ErrorMatches, "Error importing records: .*500.*")
+	c.Assert(result.Plan.Delete, HasLen, 1)
+	c.Assert(result.Applied.Update, HasLen, 1)
+	c.Assert(result.Applied.Create, HasLen, 0)
+	c.Assert(result.Applied.Delete, HasLen, 0)
+}
+
+func (s *S) Test_ImportRecords_CNAME(c *C) {
+	testServer.Response(200, nil, `{"domain_records": [{"id": 3, "type": "A", "name": "www", "data": "1.2.3.4", "ttl": 1800}], "links": {}, "meta": {"total": 1}}`)
+	testServer.Response(204, nil, "")
+	testServer.Response(201, nil, recordExample)
+
+	records := []Record{{Type: "CNAME", Name: "www", Data: "example.com."}}
+
+	_, err := s.client.ImportRecords("example.com", records, nil)
+
+	reqs := testServer.WaitRequests(3)
+
+	c.Assert(err, IsNil)
+
+	// The A record has to go before the CNAME can be created
+	c.Assert(reqs[1].Method, Equals, "DELETE")
+	c.Assert(reqs[1].URL.Path, Equals, "/domains/example.com/records/3")
+	c.Assert(reqs[2].Method, Equals, "POST")
+}
+
+func (s *S) Test_ImportRecords_Invalid(c *C) {
+	records := []Record{{Type: "A", Name: "www", Data: "2001:db8::1"}}
+
+	_, err := s.client.ImportRecords("example.com", records, nil)
+
+	c.Assert(err, ErrorMatches, "Error importing records: A www: Error validating record: .*")
+}
+
+func (s *S) Test_ImportZoneFile_Unsupported(c *C) {
+	cases := map[string]string{
+		"1.2.3.4.in-addr.arpa. IN PTR host.example.com.\n": "Error importing records: PTR 1.2.3.4.in-addr.arpa.: unsupported record type",
+		"@ IN HINFO \"PC\" \"Linux\"\n":                    "Error importing records: HINFO @: unsupported record type",
+		"www.other.org. IN A 1.2.3.4\n":                    "Error importing records: A www.other.org.: name is outside of example.com",
+	}
+
+	for zone, expected := range cases {
+		var calls []string
+		client := middlewareClient(c, tagMiddleware("request", &calls))
+
+		_, err := client.ImportZoneFile("example.com", "$ORIGIN example.com.\n"+zone, nil)
+
+		c.Assert(err, ErrorMatches, regexp.QuoteMeta(expected))
+		c.Assert(calls, HasLen, 0)
+	}
+}
+
+func (s *S) Test_ImportRecords_AbsoluteNames(c *C) {
+	testServer.Response(200, nil, recordsExample)
+
+	records := []Record{
+		{Type: "mx", Name: "example.com.", Priority: 10, TTL: 1800, Data: "mx1.example.com."},
+		{Type: "MX", Name: "@", Priority: 20, TTL: 1800, Data: "mx2.example.com."},
+	}
+
+	result, err := s.client.ImportRecords("example.com", records, &ImportZone{DryRun: true})
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, IsNil)
+	c.Assert(result.Plan.Empty(), Equals, true)
+	c.Assert(records[0].Name, Equals, "example.com.")
+}
+
+func TestPlanZone(t *testing.T) {
+	existing := []Record{
+		{Id: 1, Type: "SOA", Name: "@", Data: "ns1.digitalocean.com. hostmaster.example.com. 1 10800 3600 604800 1800"},
+		{Id: 2, Type: "NS", Name: "@", Data: "ns1.digitalocean.com"},
+		{Id: 3, Type: "A", Name: "www", TTL: 1800, Data: "1.2.3.4"},
+		{Id: 4, Type: "CNAME", Name: "blog", TTL: 1800, Data: "@"},
+		{Id: 5, Type: "TXT", Name: "@", TTL: 1800, Data: "v=spf1 -all"},
+	}
+
+	desired := []Record{
+		{Type: "CNAME", Name: "www", Data: "example.com."},
+		{Type: "CNAME", Name: "blog", Data: "example.com."},
+		{Type: "TXT", Name: "@", TTL: 300, Data: "v=spf1 -all"},
+	}
+
+	plan := PlanZone("example.com", existing, desired)
+
+	expected := ZonePlan{
+		Create: []Record{desired[0]},
+		Update: []RecordUpdate{{existing[4], desired[2]}},
+		Delete: []Record{existing[2]},
+	}
+
+	if !reflect.DeepEqual(plan, expected) {
+		t.Fatalf("bad plan:\n%#v\n%#v", plan, expected)
+	}
+
+	if plan = PlanZone("example.com", existing, existing); !plan.Empty() {
+		t.Fatalf("bad plan: %#v", plan)
+	}
+}
+
+func TestPlanZone_case(t *testing.T) {
+	existing := []Record{
+		{Id: 1, Type: "TXT", Name: "@", Data: "google-site-verification=abcDEF"},
+		{Id: 2, Type: "CNAME", Name: "www", Data: "Example.COM."},
+	}
+
+	desired := []Record{
+		{Type: "TXT", Name: "@", Data: "google-site-verification=ABCdef"},
+		{Type: "CNAME", Name: "WWW", Data: "example.com."},
+	}
+
+	plan := PlanZone("example.com", existing, desired)
+
+	// Only domain names are case insensitive
+	expected := ZonePlan{
+		Update: []RecordUpdate{{existing[0], desired[0]}},
+	}
+
+	if !reflect.DeepEqual(plan, expected) {
+		t.Fatalf("bad plan:\n%#v\n%#v", plan, expected)
+	}
+}
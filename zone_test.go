@@ -0,0 +1,199 @@
+package digitalocean
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	. "github.com/motain/gocheck"
+)
+
+func TestZone(t *testing.T) {
+	TestingT(t)
+}
+
+func (s *S) Test_ExportZoneFile(c *C) {
+	testServer.Response(200, nil, domainZoneFileExample)
+	testServer.Response(200, nil, recordsExample)
+
+	zone, err := s.client.ExportZoneFile("example.com")
+
+	reqs := testServer.WaitRequests(2)
+
+	c.Assert(err, IsNil)
+	c.Assert(reqs[0].URL.Path, Equals, "/domains/example.com")
+	c.Assert(reqs[1].URL.Path, Equals, "/domains/example.com/records")
+	c.Assert(zone, Equals, `$ORIGIN example.com.
+$TTL 1800
+@	1800	IN	SOA	ns1.digitalocean.com. hostmaster.example.com. 1415982611 10800 3600 604800 1800
+@	1800	IN	MX	10 mx1.example.com.
+@	1800	IN	MX	20 mx2.example.com.
+`)
+}
+
+func (s *S) Test_ExportZoneFile_BadZone(c *C) {
+	testServer.Response(200, nil, `{"domain": {"name": "example.com", "ttl": 1800, "zone_file": "@ IN SOA ns1.digitalocean.com. (\n"}}`)
+
+	_, err := s.client.ExportZoneFile("example.com")
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, ErrorMatches, "Error exporting zone file: Error parsing zone file: unbalanced parentheses")
+}
+
+func (s *S) Test_ExportZoneFile_NoSOA(c *C) {
+	testServer.Response(200, nil, domainExample)
+
+	_, err := s.client.ExportZoneFile("example.com")
+
+	_ = testServer.WaitRequest()
+
+	c.Assert(err, ErrorMatches, "Error exporting zone file: the zone file of example.com has no SOA record")
+}
+
+func TestParseZoneFile(t *testing.T) {
+	records, err := ParseZoneFile("example.com", zoneFileExample)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	expected := []Record{
+		{Type: "SOA", Name: "@", TTL: 1800, Data: "ns1.digitalocean.com. hostmaster.example.com. 1415982611 10800 3600 604800 1800"},
+		{Type: "NS", Name: "@", TTL: 1800, Data: "ns1.digitalocean.com."},
+		{Type: "A", Name: "@", TTL: 1800, Data: "1.2.3.4"},
+		{Type: "AAAA", Name: "@", TTL: 3600, Data: "2001:db8::1"},
+		{Type: "CNAME", Name: "www", TTL: 1800, Data: "example.com."},
+		{Type: "MX", Name: "@", TTL: 1800, Priority: 10, Data: "mail.example.com."},
+		{Type: "TXT", Name: "@", TTL: 1800, Data: "v=spf1 include:_spf.example.org -all"},
+		{Type: "TXT", Name: "dkim._domainkey", TTL: 1800, Data: `k=rsa; p="abc"`},
+		{Type: "SRV", Name: "_sip._tcp", TTL: 5400, Priority: 10, Weight: 60, Port: 5060, Data: "sip.example.com."},
+		{Type: "CAA", Name: "@", TTL: 1800, Flags: 0, Tag: "issue", Data: "letsencrypt.org"},
+		{Type: "A", Name: "api.staging", TTL: 1800, Data: "10.0.0.1"},
+		{Type: "CNAME", Name: "web.staging", TTL: 1800, Data: "api.staging.example.com."},
+	}
+
+	if !reflect.DeepEqual(records, expected) {
+		t.Fatalf("bad records:\n%#v\n%#v", records, expected)
+	}
+}
+
+func TestParseZoneFile_errors(t *testing.T) {
+	cases := map[string]string{
+		"@ IN MX mail.example.com.\n":     "Error parsing zone file: line 1: MX record needs 2 fields, got 1",
+		"@ IN A (1.2.3.4\n":               "Error parsing zone file: unbalanced parentheses",
+		"@ IN TXT \"unterminated\n":       "Error parsing zone file: line 1: unterminated string",
+		"$INCLUDE other.zone\n":           "Error parsing zone file: line 1: unsupported directive $INCLUDE",
+		"$TTL soon\n":                     "Error parsing zone file: line 1: bad TTL \"soon\"",
+		"@ IN MX ten mail.example.com.\n": "Error parsing zone file: line 1: MX record has a bad number \"ten\"",
+	}
+
+	for zone, expected := range cases {
+		_, err := ParseZoneFile("example.com", zone)
+		if err == nil || err.Error() != expected {
+			t.Fatalf("bad error for %q: %v", zone, err)
+		}
+	}
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	records := []Record{
+		{Type: "A", Name: "@", Data: "1.2.3.4"},
+		{Type: "MX", Name: "@", TTL: 3600, Priority: 10, Data: "mail.example.com."},
+		{Type: "SRV", Name: "_sip._tcp", Priority: 10, Weight: 60, Port: 5060, Data: "sip"},
+		{Type: "CAA", Name: "@", Flags: 128, Tag: "iodef", Data: "mailto:security@example.com"},
+		{Type: "TXT", Name: "txt", Data: `say "hi"`},
+	}
+
+	zone := RenderZoneFile("example.com", 1800, records)
+
+	expected := `$ORIGIN example.com.
+$TTL 1800
+@	IN	A	1.2.3.4
+@	3600	IN	MX	10 mail.example.com.
+_sip._tcp	IN	SRV	10 60 5060 sip
+@	IN	CAA	128 iodef "mailto:security@example.com"
+txt	IN	TXT	"say \"hi\""
+`
+
+	if zone != expected {
+		t.Fatalf("bad zone:\n%s", zone)
+	}
+}
+
+func TestRenderZoneFile_roundTrip(t *testing.T) {
+	records, err := ParseZoneFile("example.com.", zoneFileExample)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	parsed, err := ParseZoneFile("example.com", RenderZoneFile("example.com", 0, records))
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if !reflect.DeepEqual(parsed, records) {
+		t.Fatalf("bad round trip:\n%#v\n%#v", parsed, records)
+	}
+}
+
+func TestRenderZoneFile_longTXT(t *testing.T) {
+	data := strings.Repeat("a", 300)
+	zone := RenderZoneFile("example.com", 0, []Record{{Type: "TXT", Name: "@", Data: data}})
+
+	if !strings.Contains(zone, `"`+data[:255]+`" "`+data[255:]+`"`) {
+		t.Fatalf("TXT data not split:\n%s", zone)
+	}
+
+	records, err := ParseZoneFile("example.com", zone)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if records[0].Data != data {
+		t.Fatalf("bad data: %s", records[0].Data)
+	}
+}
+
+func TestDomain_Records(t *testing.T) {
+	d := Domain{Name: "example.com", ZoneFile: zoneFileExample}
+
+	records, err := d.Records()
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if len(records) != 12 {
+		t.Fatalf("bad records: %#v", records)
+	}
+}
+
+var zoneFileExample = `$ORIGIN example.com.
+$TTL 1800
+example.com. IN SOA ns1.digitalocean.com. hostmaster.example.com. (
+	1415982611 ; serial
+	10800      ; refresh
+	3600       ; retry
+	604800     ; expire
+	1800 )     ; minimum
+@ IN NS ns1.digitalocean.com.
+@ IN A 1.2.3.4
+  3600 IN AAAA 2001:db8::1
+www IN CNAME @
+@ IN MX 10 mail
+@ IN TXT "v=spf1 " "include:_spf.example.org" " -all"
+dkim._domainkey IN TXT "k=rsa; p=\"abc\""
+_sip._tcp 1h30m IN SRV 10 60 5060 sip.example.com.
+@ IN CAA 0 issue "letsencrypt.org"
+
+$ORIGIN staging.example.com.
+api IN A 10.0.0.1 ; internal
+web CNAME api
+`
+
+var domainZoneFileExample = `{
+  "domain": {
+    "name": "example.com",
+    "ttl": 1800,
+    "zone_file": "$ORIGIN example.com.\n$TTL 1800\nexample.com. IN SOA ns1.digitalocean.com. hostmaster.example.com. 1415982611 10800 3600 604800 1800\nexample.com. 1800 IN NS ns1.digitalocean.com.\n"
+  }
+}`